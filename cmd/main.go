@@ -14,24 +14,47 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/batch_services"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/create_bay"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/create_company"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/create_service"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/create_service_from_template"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/create_service_template"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/delete_bay"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/delete_company"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/delete_service"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/delete_service_template"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/get_address_slots"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/get_bay"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/get_company"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/get_service"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/get_service_template"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/list_bays"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/list_companies"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/list_service_revisions"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/list_service_templates"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/list_services"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/replace_busy_intervals"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/update_bay"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/update_company"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers/update_service"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers/update_service_template"
 	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
 	"github.com/m04kA/SMK-SellerService/internal/config"
+	bayRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/bay"
 	companyRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/company"
+	outboxRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/outbox"
+	scheduleRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/schedule"
 	serviceRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/service"
+	templateRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/template"
 	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice"
 	companiesService "github.com/m04kA/SMK-SellerService/internal/service/companies"
+	priceSyncService "github.com/m04kA/SMK-SellerService/internal/service/pricesync"
 	servicesService "github.com/m04kA/SMK-SellerService/internal/service/services"
+	slotsService "github.com/m04kA/SMK-SellerService/internal/service/slots"
+	templatesService "github.com/m04kA/SMK-SellerService/internal/service/templates"
 	"github.com/m04kA/SMK-SellerService/pkg/dbmetrics"
+	"github.com/m04kA/SMK-SellerService/pkg/jwt"
 	"github.com/m04kA/SMK-SellerService/pkg/logger"
 	"github.com/m04kA/SMK-SellerService/pkg/metrics"
 )
@@ -85,12 +108,54 @@ func main() {
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.DBName)
 
 	// Инициализируем PriceService клиент
-	priceClient := priceservice.NewClient(cfg.PriceService.BaseURL, log)
-	log.Info("PriceService client initialized (base_url=%s)", cfg.PriceService.BaseURL)
+	var breakerReporter priceservice.BreakerReporter
+	if cfg.Metrics.Enabled {
+		breakerReporter = metricsCollector
+	}
+	priceBreaker := priceservice.NewBreaker("priceservice", priceservice.BreakerConfig{
+		FailureThreshold: cfg.PriceService.BreakerFailureThreshold,
+		CoolDown:         time.Duration(cfg.PriceService.BreakerCoolDownMs) * time.Millisecond,
+		HalfOpenMaxCalls: cfg.PriceService.BreakerHalfOpenMaxCalls,
+	}, breakerReporter, log)
+	priceClient := priceservice.NewClient(priceservice.EndpointConfig{
+		URLs:               cfg.PriceService.BaseURLs,
+		Balancing:          cfg.PriceService.Balancing,
+		EjectAfterFailures: cfg.PriceService.EjectAfterFailures,
+		EjectDuration:      time.Duration(cfg.PriceService.EjectDurationMs) * time.Millisecond,
+		HedgeDelay:         time.Duration(cfg.PriceService.HedgeDelayMs) * time.Millisecond,
+	}, priceservice.HTTPConfig{
+		Timeout:         time.Duration(cfg.PriceService.TimeoutMs) * time.Millisecond,
+		MaxIdleConns:    cfg.PriceService.MaxIdleConns,
+		MaxConnsPerHost: cfg.PriceService.MaxConnsPerHost,
+		IdleConnTimeout: time.Duration(cfg.PriceService.IdleConnTimeoutMs) * time.Millisecond,
+	}, priceservice.RetryPolicy{
+		MaxAttempts: cfg.PriceService.MaxAttempts,
+		BaseDelay:   time.Duration(cfg.PriceService.RetryBaseDelayMs) * time.Millisecond,
+		MaxDelay:    time.Duration(cfg.PriceService.RetryMaxDelayMs) * time.Millisecond,
+	}, priceservice.ChunkConfig{
+		Size:        cfg.PriceService.ChunkSize,
+		Concurrency: cfg.PriceService.ChunkConcurrency,
+	}, priceservice.CredentialsConfig{
+		Mode:       cfg.PriceService.AuthMode,
+		CallerID:   cfg.PriceService.CallerID,
+		Token:      cfg.PriceService.AuthToken,
+		HMACSecret: cfg.PriceService.HMACSecret,
+	}, priceBreaker, log)
+	priceCache := priceservice.NewCachedClient(priceClient, priceservice.CacheConfig{
+		TTL:        time.Duration(cfg.PriceService.CacheTTLMs) * time.Millisecond,
+		MaxEntries: cfg.PriceService.CacheMaxEntries,
+	}, log)
+	enrichBudget := time.Duration(cfg.PriceService.EnrichBudgetMs) * time.Millisecond
+	backgroundTimeout := time.Duration(cfg.PriceService.BackgroundTimeoutMs) * time.Millisecond
+	log.Info("PriceService client initialized (base_urls=%v, auth_mode=%s, balancing=%s, hedge_delay=%dms, timeout=%dms, enrich_budget=%dms, max_attempts=%d)",
+		cfg.PriceService.BaseURLs, cfg.PriceService.AuthMode, cfg.PriceService.Balancing, cfg.PriceService.HedgeDelayMs, cfg.PriceService.TimeoutMs, cfg.PriceService.EnrichBudgetMs, cfg.PriceService.MaxAttempts)
 
 	// Инициализируем репозитории и сервисы (с метриками или без)
 	var companySvc *companiesService.Service
 	var serviceSvc *servicesService.Service
+	var templateSvc *templatesService.Service
+	var slotSvc *slotsService.Service
+	var outboxRepository *outboxRepo.Repository
 
 	if cfg.Metrics.Enabled {
 		wrappedDB = dbmetrics.WrapWithDefault(db, metricsCollector, cfg.Metrics.ServiceName, stopMetricsCh)
@@ -99,18 +164,51 @@ func main() {
 		// Инициализируем репозитории с обёрткой метрик
 		companyRepository := companyRepo.NewRepository(wrappedDB)
 		serviceRepository := serviceRepo.NewRepository(wrappedDB)
-
-		companySvc = companiesService.NewService(companyRepository)
-		serviceSvc = servicesService.NewService(serviceRepository, companyRepository, priceClient)
+		templateRepository := templateRepo.NewRepository(wrappedDB)
+		scheduleRepository := scheduleRepo.NewRepository(wrappedDB)
+		bayRepository := bayRepo.NewRepository(wrappedDB)
+		outboxRepository = outboxRepo.NewRepository(wrappedDB)
+
+		// Цены: PriceService (через кэш), при деградации - базовые цены услуг
+		priceProvider := priceservice.NewCompositeClient(priceCache, serviceRepository, enrichBudget, backgroundTimeout, cfg.PriceService.BatchConcurrency, log)
+		companySvc = companiesService.NewService(companyRepository, serviceRepository, priceProvider)
+		serviceSvc = servicesService.NewService(serviceRepository, companyRepository, templateRepository, bayRepository, priceProvider, priceCache)
+		templateSvc = templatesService.NewService(templateRepository)
+		slotSvc = slotsService.NewService(companyRepository, serviceRepository, scheduleRepository, bayRepository, cfg.Slots.StepMinutes)
 	} else {
 		// Инициализируем репозитории без метрик
 		companyRepository := companyRepo.NewRepository(db)
 		serviceRepository := serviceRepo.NewRepository(db)
-
-		companySvc = companiesService.NewService(companyRepository)
-		serviceSvc = servicesService.NewService(serviceRepository, companyRepository, priceClient)
+		templateRepository := templateRepo.NewRepository(db)
+		scheduleRepository := scheduleRepo.NewRepository(db)
+		bayRepository := bayRepo.NewRepository(db)
+		outboxRepository = outboxRepo.NewRepository(db)
+
+		// Цены: PriceService (через кэш), при деградации - базовые цены услуг
+		priceProvider := priceservice.NewCompositeClient(priceCache, serviceRepository, enrichBudget, backgroundTimeout, cfg.PriceService.BatchConcurrency, log)
+		companySvc = companiesService.NewService(companyRepository, serviceRepository, priceProvider)
+		serviceSvc = servicesService.NewService(serviceRepository, companyRepository, templateRepository, bayRepository, priceProvider, priceCache)
+		templateSvc = templatesService.NewService(templateRepository)
+		slotSvc = slotsService.NewService(companyRepository, serviceRepository, scheduleRepository, bayRepository, cfg.Slots.StepMinutes)
 	}
 
+	// Доставка событий жизненного цикла услуг в PriceService
+	priceSyncWorker := priceSyncService.NewWorker(outboxRepository, priceClient, priceSyncService.Config{
+		PollInterval:   time.Duration(cfg.PriceService.SyncPollIntervalMs) * time.Millisecond,
+		BatchSize:      cfg.PriceService.SyncBatchSize,
+		RequestTimeout: time.Duration(cfg.PriceService.TimeoutMs) * time.Millisecond,
+		BaseDelay:      time.Duration(cfg.PriceService.SyncRetryBaseDelayMs) * time.Millisecond,
+		MaxDelay:       time.Duration(cfg.PriceService.SyncRetryMaxDelayMs) * time.Millisecond,
+	}, log)
+	priceSyncCtx, stopPriceSync := context.WithCancel(context.Background())
+	priceSyncDone := make(chan struct{})
+	go func() {
+		defer close(priceSyncDone)
+		priceSyncWorker.Run(priceSyncCtx)
+	}()
+	log.Info("PriceService sync worker started (poll_interval=%dms, batch_size=%d)",
+		cfg.PriceService.SyncPollIntervalMs, cfg.PriceService.SyncBatchSize)
+
 	// Инициализируем handlers для компаний
 	createCompanyHandler := create_company.NewHandler(companySvc, log)
 	getCompanyHandler := get_company.NewHandler(companySvc, log)
@@ -124,11 +222,60 @@ func main() {
 	listServicesHandler := list_services.NewHandler(serviceSvc, log)
 	updateServiceHandler := update_service.NewHandler(serviceSvc, log)
 	deleteServiceHandler := delete_service.NewHandler(serviceSvc, log)
+	batchServicesHandler := batch_services.NewHandler(serviceSvc, log)
+	createServiceFromTemplateHandler := create_service_from_template.NewHandler(serviceSvc, log)
+	listServiceRevisionsHandler := list_service_revisions.NewHandler(serviceSvc, log)
+
+	// Инициализируем handlers для постов адресов
+	createBayHandler := create_bay.NewHandler(serviceSvc, log)
+	getBayHandler := get_bay.NewHandler(serviceSvc, log)
+	listBaysHandler := list_bays.NewHandler(serviceSvc, log)
+	updateBayHandler := update_bay.NewHandler(serviceSvc, log)
+	deleteBayHandler := delete_bay.NewHandler(serviceSvc, log)
+
+	// Инициализируем handlers для шаблонов услуг
+	createServiceTemplateHandler := create_service_template.NewHandler(templateSvc, log)
+	getServiceTemplateHandler := get_service_template.NewHandler(templateSvc, log)
+	listServiceTemplatesHandler := list_service_templates.NewHandler(templateSvc, log)
+	updateServiceTemplateHandler := update_service_template.NewHandler(templateSvc, log)
+	deleteServiceTemplateHandler := delete_service_template.NewHandler(templateSvc, log)
+
+	// Инициализируем handlers для слотов записи
+	getAddressSlotsHandler := get_address_slots.NewHandler(slotSvc, log)
+	replaceBusyIntervalsHandler := replace_busy_intervals.NewHandler(slotSvc, log)
+
+	// Аутентификация пользователей
+	var authenticator middleware.Authenticator
+	switch cfg.Auth.Mode {
+	case "jwt":
+		jwtCfg := jwt.Config{
+			HMACSecret: []byte(cfg.Auth.JWTSecret),
+			Issuer:     cfg.Auth.Issuer,
+			Audience:   cfg.Auth.Audience,
+			Leeway:     time.Duration(cfg.Auth.LeewayMs) * time.Millisecond,
+		}
+		if cfg.Auth.JWKSFile != "" {
+			keys, err := jwt.NewKeySet(cfg.Auth.JWKSFile, time.Duration(cfg.Auth.JWKSCheckIntervalMs)*time.Millisecond, log)
+			if err != nil {
+				log.Fatal("Failed to load JWKS file: %v", err)
+			}
+			jwtCfg.Keys = keys
+		}
+		authenticator = middleware.NewJWTAuthenticator(jwt.NewVerifier(jwtCfg), cfg.Auth.UserIDClaim, cfg.Auth.RoleClaim)
+		log.Info("JWT authentication enabled (hs256=%t, jwks_file=%q, issuer=%q, audience=%q)",
+			cfg.Auth.JWTSecret != "", cfg.Auth.JWKSFile, cfg.Auth.Issuer, cfg.Auth.Audience)
+	default:
+		authenticator = middleware.NewHeaderAuthenticator()
+		log.Warn("Header authentication enabled: X-User-ID and X-User-Role are trusted, run only behind a trusted gateway")
+	}
 
 	// Настраиваем роутер
 	r := mux.NewRouter()
 
 	// Добавляем metrics middleware (если метрики включены)
+	// ID запроса и роль пользователя передаются в исходящие вызовы PriceService
+	r.Use(middleware.RequestContext)
+
 	if cfg.Metrics.Enabled {
 		r.Use(middleware.MetricsMiddleware(metricsCollector, cfg.Metrics.ServiceName))
 		log.Info("HTTP metrics middleware enabled")
@@ -142,6 +289,7 @@ func main() {
 
 	// API prefix
 	api := r.PathPrefix("/api/v1").Subrouter()
+	api.Use(middleware.Identify(authenticator))
 
 	// Public routes для компаний
 	api.HandleFunc("/companies", listCompaniesHandler.Handle).Methods(http.MethodGet)
@@ -151,7 +299,19 @@ func main() {
 	api.HandleFunc("/companies/{company_id}/services", listServicesHandler.Handle).Methods(http.MethodGet)
 	api.HandleFunc("/companies/{company_id}/services/{service_id}", getServiceHandler.Handle).Methods(http.MethodGet)
 
-	// Protected routes (требуют X-User-ID и X-User-Role)
+	// Public routes для слотов записи
+	api.HandleFunc("/companies/{company_id}/addresses/{address_id}/slots", getAddressSlotsHandler.Handle).Methods(http.MethodGet)
+
+	// Public routes для постов адресов
+	api.HandleFunc("/companies/{company_id}/addresses/{address_id}/bays", listBaysHandler.Handle).Methods(http.MethodGet)
+	api.HandleFunc("/companies/{company_id}/addresses/{address_id}/bays/{bay_id}", getBayHandler.Handle).Methods(http.MethodGet)
+
+	// Public routes для шаблонов услуг
+	api.HandleFunc("/service-templates", listServiceTemplatesHandler.Handle).Methods(http.MethodGet)
+	api.HandleFunc("/service-templates/{template_id}", getServiceTemplateHandler.Handle).Methods(http.MethodGet)
+
+	// Protected routes (требуют пользователя и известную роль: JWT или X-User-ID и X-User-Role)
+	// Права ролей проверяют сервисы по матрице internal/service/permissions.go
 	protected := api.PathPrefix("").Subrouter()
 	protected.Use(middleware.Auth)
 
@@ -164,6 +324,22 @@ func main() {
 	protected.HandleFunc("/companies/{company_id}/services", createServiceHandler.Handle).Methods(http.MethodPost)
 	protected.HandleFunc("/companies/{company_id}/services/{service_id}", updateServiceHandler.Handle).Methods(http.MethodPut)
 	protected.HandleFunc("/companies/{company_id}/services/{service_id}", deleteServiceHandler.Handle).Methods(http.MethodDelete)
+	protected.HandleFunc("/companies/{company_id}/services:batch", batchServicesHandler.Handle).Methods(http.MethodPost)
+	protected.HandleFunc("/companies/{company_id}/services/from-template/{template_id}", createServiceFromTemplateHandler.Handle).Methods(http.MethodPost)
+	protected.HandleFunc("/companies/{company_id}/services/{service_id}/revisions", listServiceRevisionsHandler.Handle).Methods(http.MethodGet)
+
+	// Protected routes для занятости адресов (superuser или менеджер компании)
+	protected.HandleFunc("/companies/{company_id}/addresses/{address_id}/busy-intervals", replaceBusyIntervalsHandler.Handle).Methods(http.MethodPut)
+
+	// Protected routes для постов адресов (superuser или менеджер компании)
+	protected.HandleFunc("/companies/{company_id}/addresses/{address_id}/bays", createBayHandler.Handle).Methods(http.MethodPost)
+	protected.HandleFunc("/companies/{company_id}/addresses/{address_id}/bays/{bay_id}", updateBayHandler.Handle).Methods(http.MethodPut)
+	protected.HandleFunc("/companies/{company_id}/addresses/{address_id}/bays/{bay_id}", deleteBayHandler.Handle).Methods(http.MethodDelete)
+
+	// Protected routes для шаблонов услуг (только superuser)
+	protected.HandleFunc("/service-templates", createServiceTemplateHandler.Handle).Methods(http.MethodPost)
+	protected.HandleFunc("/service-templates/{template_id}", updateServiceTemplateHandler.Handle).Methods(http.MethodPut)
+	protected.HandleFunc("/service-templates/{template_id}", deleteServiceTemplateHandler.Handle).Methods(http.MethodDelete)
 
 	// Создаем HTTP сервер
 	addr := fmt.Sprintf(":%d", cfg.Server.HTTPPort)
@@ -190,6 +366,11 @@ func main() {
 
 	log.Info("Shutting down server...")
 
+	// Останавливаем доставку событий в PriceService, недоставленные останутся в outbox
+	stopPriceSync()
+	<-priceSyncDone
+	log.Info("PriceService sync worker stopped")
+
 	// Останавливаем сбор метрик connection pool
 	if cfg.Metrics.Enabled {
 		close(stopMetricsCh)
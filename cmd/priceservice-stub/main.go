@@ -0,0 +1,22 @@
+// Локальная замена PriceService для разработки: go run ./cmd/priceservice-stub -addr :8082
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice/stub"
+)
+
+func main() {
+	addr := flag.String("addr", ":8082", "listen address")
+	price := flag.Float64("price", 1000, "price returned for every service")
+	currency := flag.String("currency", "RUB", "price currency")
+	flag.Parse()
+
+	log.Printf("PriceService stub listening on %s", *addr)
+	if err := http.ListenAndServe(*addr, stub.NewServer(*price, *currency)); err != nil {
+		log.Fatal(err)
+	}
+}
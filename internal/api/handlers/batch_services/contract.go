@@ -0,0 +1,17 @@
+package batch_services
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+type ServiceService interface {
+	Batch(ctx context.Context, companyID int64, userID int64, userRole string, req *models.BatchServicesRequest, atomic bool) (*models.BatchServicesResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
@@ -0,0 +1,110 @@
+package batch_services
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/services"
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+const (
+	msgInvalidRequestBody = "invalid request body"
+	msgInvalidCompanyID   = "invalid company ID"
+	msgInvalidAtomicParam = "invalid atomic parameter"
+	msgForbidden          = "access denied"
+	msgCompanyNotFound    = "company not found"
+	msgMissingUserID      = "missing user ID"
+	msgMissingUserRole    = "missing user role"
+)
+
+type Handler struct {
+	service ServiceService
+	logger  Logger
+}
+
+func NewHandler(service ServiceService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle POST /api/v1/companies/{company_id}/services:batch
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	userRole, ok := middleware.GetUserRole(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserRole)
+		return
+	}
+
+	vars := mux.Vars(r)
+	companyIDStr := vars["company_id"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("POST /companies/{company_id}/services:batch - Invalid company ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		return
+	}
+
+	// По умолчанию пакет атомарный
+	atomic := true
+	if atomicStr := r.URL.Query().Get("atomic"); atomicStr != "" {
+		atomic, err = strconv.ParseBool(atomicStr)
+		if err != nil {
+			h.logger.Warn("POST /companies/{company_id}/services:batch - Invalid atomic parameter: %v", err)
+			handlers.RespondBadRequest(w, msgInvalidAtomicParam)
+			return
+		}
+	}
+
+	var req models.BatchServicesRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.Warn("POST /companies/{company_id}/services:batch - Invalid request body: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidRequestBody)
+		return
+	}
+
+	response, err := h.service.Batch(r.Context(), companyID, userID, userRole, &req, atomic)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			h.logger.Warn("POST /companies/{company_id}/services:batch - Invalid batch: company_id=%d, error=%v", companyID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
+		if errors.Is(err, services.ErrCompanyNotFound) {
+			h.logger.Warn("POST /companies/{company_id}/services:batch - Company not found: company_id=%d", companyID)
+			handlers.RespondNotFound(w, msgCompanyNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrAccessDenied) {
+			h.logger.Warn("POST /companies/{company_id}/services:batch - Access denied: company_id=%d, user_id=%d", companyID, userID)
+			handlers.RespondForbidden(w, msgForbidden)
+			return
+		}
+		h.logger.Error("POST /companies/{company_id}/services:batch - Failed to apply batch: company_id=%d, user_id=%d, error=%v", companyID, userID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	// Атомарный пакет, который был откачен, возвращаем с 422 и результатами по операциям
+	if atomic && !response.Committed {
+		h.logger.Warn("POST /companies/{company_id}/services:batch - Batch rolled back: company_id=%d, user_id=%d, operations=%d", companyID, userID, len(response.Results))
+		handlers.RespondJSON(w, http.StatusUnprocessableEntity, response)
+		return
+	}
+
+	h.logger.Info("POST /companies/{company_id}/services:batch - Batch applied: company_id=%d, user_id=%d, atomic=%t, operations=%d", companyID, userID, atomic, len(response.Results))
+	handlers.RespondJSON(w, http.StatusOK, response)
+}
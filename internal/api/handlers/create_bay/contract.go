@@ -0,0 +1,17 @@
+package create_bay
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+type BayService interface {
+	CreateBay(ctx context.Context, companyID int64, addressID int64, userID int64, userRole string, req *models.CreateBayRequest) (*models.BayResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
@@ -0,0 +1,115 @@
+package create_bay
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/services"
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+const (
+	msgInvalidRequestBody  = "invalid request body"
+	msgInvalidCompanyID    = "invalid company ID"
+	msgInvalidAddressID    = "invalid address ID"
+	msgForbidden           = "access denied"
+	msgCompanyNotFound     = "company not found"
+	msgAddressNotFound     = "address not found"
+	msgServiceNotAtAddress = "services are not offered at this address"
+	msgMissingUserID       = "missing user ID"
+	msgMissingUserRole     = "missing user role"
+)
+
+type Handler struct {
+	service BayService
+	logger  Logger
+}
+
+func NewHandler(service BayService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle POST /api/v1/companies/{company_id}/addresses/{address_id}/bays
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	userRole, ok := middleware.GetUserRole(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserRole)
+		return
+	}
+
+	vars := mux.Vars(r)
+	companyIDStr := vars["company_id"]
+	addressIDStr := vars["address_id"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("POST /companies/{company_id}/addresses/{address_id}/bays - Invalid company ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		return
+	}
+
+	addressID, err := strconv.ParseInt(addressIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("POST /companies/{company_id}/addresses/{address_id}/bays - Invalid address ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidAddressID)
+		return
+	}
+
+	var req models.CreateBayRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.Warn("POST /companies/{company_id}/addresses/{address_id}/bays - Invalid request body: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidRequestBody)
+		return
+	}
+
+	bay, err := h.service.CreateBay(r.Context(), companyID, addressID, userID, userRole, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrCompanyNotFound) {
+			h.logger.Warn("POST /companies/{company_id}/addresses/{address_id}/bays - Company not found: company_id=%d", companyID)
+			handlers.RespondNotFound(w, msgCompanyNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrAccessDenied) {
+			h.logger.Warn("POST /companies/{company_id}/addresses/{address_id}/bays - Access denied: company_id=%d, user_id=%d", companyID, userID)
+			handlers.RespondForbidden(w, msgForbidden)
+			return
+		}
+		if errors.Is(err, services.ErrAddressNotFound) {
+			h.logger.Warn("POST /companies/{company_id}/addresses/{address_id}/bays - Address not found: company_id=%d, address_id=%d", companyID, addressID)
+			handlers.RespondNotFound(w, msgAddressNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			h.logger.Warn("POST /companies/{company_id}/addresses/{address_id}/bays - Invalid input: company_id=%d, address_id=%d, error=%v", companyID, addressID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
+		var servicesErr *services.BayServicesError
+		if errors.As(err, &servicesErr) {
+			h.logger.Warn("POST /companies/{company_id}/addresses/{address_id}/bays - Services not at address: company_id=%d, address_id=%d, service_ids=%v", companyID, addressID, servicesErr.ServiceIDs)
+			handlers.RespondUnprocessableEntity(w, msgServiceNotAtAddress, map[string]interface{}{
+				"service_ids": servicesErr.ServiceIDs,
+			})
+			return
+		}
+		h.logger.Error("POST /companies/{company_id}/addresses/{address_id}/bays - Failed to create bay: company_id=%d, address_id=%d, user_id=%d, error=%v", companyID, addressID, userID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("POST /companies/{company_id}/addresses/{address_id}/bays - Bay created successfully: company_id=%d, address_id=%d, bay_id=%d, user_id=%d", companyID, addressID, bay.ID, userID)
+	handlers.RespondJSON(w, http.StatusCreated, bay)
+}
@@ -52,14 +52,14 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	company, err := h.service.Create(r.Context(), userID, userRole, &req)
 	if err != nil {
-		if errors.Is(err, companies.ErrOnlySuperuser) {
+		if errors.Is(err, companies.ErrAccessDenied) {
 			h.logger.Warn("POST /companies - Access denied: user_id=%d, role=%s", userID, userRole)
 			handlers.RespondForbidden(w, msgForbidden)
 			return
 		}
-		if errors.Is(err, companies.ErrAccessDenied) {
-			h.logger.Warn("POST /companies - Access denied: user_id=%d", userID)
-			handlers.RespondForbidden(w, msgForbidden)
+		if errors.Is(err, companies.ErrInvalidInput) {
+			h.logger.Warn("POST /companies - Invalid input: user_id=%d, error=%v", userID, err)
+			handlers.RespondBadRequest(w, err.Error())
 			return
 		}
 		h.logger.Error("POST /companies - Failed to create company: user_id=%d, error=%v", userID, err)
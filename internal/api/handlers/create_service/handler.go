@@ -17,6 +17,7 @@ const (
 	msgInvalidCompanyID   = "invalid company ID"
 	msgForbidden          = "access denied"
 	msgCompanyNotFound    = "company not found"
+	msgAddressNotOwned    = "addresses do not belong to company"
 	msgMissingUserID      = "missing user ID"
 	msgMissingUserRole    = "missing user role"
 )
@@ -71,6 +72,19 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 			handlers.RespondNotFound(w, msgCompanyNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			h.logger.Warn("POST /companies/{company_id}/services - Invalid input: company_id=%d, error=%v", companyID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
+		var ownershipErr *services.AddressOwnershipError
+		if errors.As(err, &ownershipErr) {
+			h.logger.Warn("POST /companies/{company_id}/services - Address not owned by company: company_id=%d, address_ids=%v", companyID, ownershipErr.AddressIDs)
+			handlers.RespondUnprocessableEntity(w, msgAddressNotOwned, map[string]interface{}{
+				"address_ids": ownershipErr.AddressIDs,
+			})
+			return
+		}
 		if errors.Is(err, services.ErrAccessDenied) {
 			h.logger.Warn("POST /companies/{company_id}/services - Access denied: company_id=%d, user_id=%d", companyID, userID)
 			handlers.RespondForbidden(w, msgForbidden)
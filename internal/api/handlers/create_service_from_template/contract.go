@@ -0,0 +1,17 @@
+package create_service_from_template
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+type ServiceService interface {
+	CreateFromTemplate(ctx context.Context, companyID int64, templateID int64, userID int64, userRole string, req *models.CreateFromTemplateRequest) (*models.ServiceResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
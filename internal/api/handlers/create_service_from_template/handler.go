@@ -0,0 +1,115 @@
+package create_service_from_template
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/services"
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+const (
+	msgInvalidRequestBody = "invalid request body"
+	msgInvalidCompanyID   = "invalid company ID"
+	msgInvalidTemplateID  = "invalid template ID"
+	msgForbidden          = "access denied"
+	msgCompanyNotFound    = "company not found"
+	msgTemplateNotFound   = "service template not found"
+	msgAddressNotOwned    = "addresses do not belong to company"
+	msgMissingUserID      = "missing user ID"
+	msgMissingUserRole    = "missing user role"
+)
+
+type Handler struct {
+	service ServiceService
+	logger  Logger
+}
+
+func NewHandler(service ServiceService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle POST /api/v1/companies/{company_id}/services/from-template/{template_id}
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	userRole, ok := middleware.GetUserRole(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserRole)
+		return
+	}
+
+	vars := mux.Vars(r)
+	companyIDStr := vars["company_id"]
+	templateIDStr := vars["template_id"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("POST /companies/{company_id}/services/from-template/{template_id} - Invalid company ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		return
+	}
+
+	templateID, err := strconv.ParseInt(templateIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("POST /companies/{company_id}/services/from-template/{template_id} - Invalid template ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidTemplateID)
+		return
+	}
+
+	var req models.CreateFromTemplateRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.Warn("POST /companies/{company_id}/services/from-template/{template_id} - Invalid request body: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidRequestBody)
+		return
+	}
+
+	service, err := h.service.CreateFromTemplate(r.Context(), companyID, templateID, userID, userRole, &req)
+	if err != nil {
+		if errors.Is(err, services.ErrCompanyNotFound) {
+			h.logger.Warn("POST /companies/{company_id}/services/from-template/{template_id} - Company not found: company_id=%d", companyID)
+			handlers.RespondNotFound(w, msgCompanyNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrTemplateNotFound) {
+			h.logger.Warn("POST /companies/{company_id}/services/from-template/{template_id} - Service template not found: template_id=%d", templateID)
+			handlers.RespondNotFound(w, msgTemplateNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			h.logger.Warn("POST /companies/{company_id}/services/from-template/{template_id} - Invalid input: company_id=%d, error=%v", companyID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
+		var ownershipErr *services.AddressOwnershipError
+		if errors.As(err, &ownershipErr) {
+			h.logger.Warn("POST /companies/{company_id}/services/from-template/{template_id} - Address not owned by company: company_id=%d, address_ids=%v", companyID, ownershipErr.AddressIDs)
+			handlers.RespondUnprocessableEntity(w, msgAddressNotOwned, map[string]interface{}{
+				"address_ids": ownershipErr.AddressIDs,
+			})
+			return
+		}
+		if errors.Is(err, services.ErrAccessDenied) {
+			h.logger.Warn("POST /companies/{company_id}/services/from-template/{template_id} - Access denied: company_id=%d, user_id=%d", companyID, userID)
+			handlers.RespondForbidden(w, msgForbidden)
+			return
+		}
+		h.logger.Error("POST /companies/{company_id}/services/from-template/{template_id} - Failed to create service: company_id=%d, template_id=%d, user_id=%d, error=%v", companyID, templateID, userID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("POST /companies/{company_id}/services/from-template/{template_id} - Service created successfully: service_id=%d, company_id=%d, template_id=%d, user_id=%d", service.ID, companyID, templateID, userID)
+	handlers.RespondJSON(w, http.StatusCreated, service)
+}
@@ -0,0 +1,17 @@
+package create_service_template
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/templates/models"
+)
+
+type TemplateService interface {
+	Create(ctx context.Context, userID int64, userRole string, req *models.CreateTemplateRequest) (*models.TemplateResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
@@ -0,0 +1,72 @@
+package create_service_template
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/templates"
+	"github.com/m04kA/SMK-SellerService/internal/service/templates/models"
+)
+
+const (
+	msgInvalidRequestBody = "invalid request body"
+	msgForbidden          = "access denied"
+	msgMissingUserID      = "missing user ID"
+	msgMissingUserRole    = "missing user role"
+)
+
+type Handler struct {
+	service TemplateService
+	logger  Logger
+}
+
+func NewHandler(service TemplateService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle POST /api/v1/service-templates
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	userRole, ok := middleware.GetUserRole(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserRole)
+		return
+	}
+
+	var req models.CreateTemplateRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.Warn("POST /service-templates - Invalid request body: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidRequestBody)
+		return
+	}
+
+	template, err := h.service.Create(r.Context(), userID, userRole, &req)
+	if err != nil {
+		if errors.Is(err, templates.ErrAccessDenied) {
+			h.logger.Warn("POST /service-templates - Access denied: user_id=%d, role=%s", userID, userRole)
+			handlers.RespondForbidden(w, msgForbidden)
+			return
+		}
+		if errors.Is(err, templates.ErrInvalidInput) {
+			h.logger.Warn("POST /service-templates - Invalid input: user_id=%d, error=%v", userID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
+		h.logger.Error("POST /service-templates - Failed to create service template: user_id=%d, error=%v", userID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("POST /service-templates - Service template created successfully: template_id=%d, user_id=%d", template.ID, userID)
+	handlers.RespondJSON(w, http.StatusCreated, template)
+}
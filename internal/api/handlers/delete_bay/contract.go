@@ -0,0 +1,13 @@
+package delete_bay
+
+import "context"
+
+type BayService interface {
+	DeleteBay(ctx context.Context, companyID int64, addressID int64, bayID int64, userID int64, userRole string) error
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
@@ -0,0 +1,107 @@
+package delete_bay
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/services"
+)
+
+const (
+	msgInvalidCompanyID = "invalid company ID"
+	msgInvalidAddressID = "invalid address ID"
+	msgInvalidBayID     = "invalid bay ID"
+	msgForbidden        = "access denied"
+	msgCompanyNotFound  = "company not found"
+	msgAddressNotFound  = "address not found"
+	msgNotFound         = "bay not found"
+	msgMissingUserID    = "missing user ID"
+	msgMissingUserRole  = "missing user role"
+)
+
+type Handler struct {
+	service BayService
+	logger  Logger
+}
+
+func NewHandler(service BayService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle DELETE /api/v1/companies/{company_id}/addresses/{address_id}/bays/{bay_id}
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	userRole, ok := middleware.GetUserRole(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserRole)
+		return
+	}
+
+	vars := mux.Vars(r)
+	companyIDStr := vars["company_id"]
+	addressIDStr := vars["address_id"]
+	bayIDStr := vars["bay_id"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("DELETE /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Invalid company ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		return
+	}
+
+	addressID, err := strconv.ParseInt(addressIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("DELETE /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Invalid address ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidAddressID)
+		return
+	}
+
+	bayID, err := strconv.ParseInt(bayIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("DELETE /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Invalid bay ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidBayID)
+		return
+	}
+
+	err = h.service.DeleteBay(r.Context(), companyID, addressID, bayID, userID, userRole)
+	if err != nil {
+		if errors.Is(err, services.ErrCompanyNotFound) {
+			h.logger.Warn("DELETE /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Company not found: company_id=%d", companyID)
+			handlers.RespondNotFound(w, msgCompanyNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrAccessDenied) {
+			h.logger.Warn("DELETE /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Access denied: company_id=%d, user_id=%d", companyID, userID)
+			handlers.RespondForbidden(w, msgForbidden)
+			return
+		}
+		if errors.Is(err, services.ErrAddressNotFound) {
+			h.logger.Warn("DELETE /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Address not found: company_id=%d, address_id=%d", companyID, addressID)
+			handlers.RespondNotFound(w, msgAddressNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrBayNotFound) {
+			h.logger.Warn("DELETE /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Bay not found: company_id=%d, address_id=%d, bay_id=%d", companyID, addressID, bayID)
+			handlers.RespondNotFound(w, msgNotFound)
+			return
+		}
+		h.logger.Error("DELETE /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Failed to delete bay: company_id=%d, bay_id=%d, user_id=%d, error=%v", companyID, bayID, userID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("DELETE /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Bay deleted successfully: company_id=%d, address_id=%d, bay_id=%d, user_id=%d", companyID, addressID, bayID, userID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -62,7 +62,7 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 			handlers.RespondNotFound(w, msgNotFound)
 			return
 		}
-		if errors.Is(err, companies.ErrOnlySuperuser) {
+		if errors.Is(err, companies.ErrAccessDenied) {
 			h.logger.Warn("DELETE /companies/{id} - Access denied: company_id=%d, user_id=%d, role=%s", id, userID, userRole)
 			handlers.RespondForbidden(w, msgForbidden)
 			return
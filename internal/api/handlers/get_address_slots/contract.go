@@ -0,0 +1,17 @@
+package get_address_slots
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/slots/models"
+)
+
+type SlotService interface {
+	GetSlots(ctx context.Context, companyID int64, addressID int64, req *models.SlotsRequest) (*models.SlotsResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
@@ -0,0 +1,129 @@
+package get_address_slots
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/service/slots"
+	"github.com/m04kA/SMK-SellerService/internal/service/slots/models"
+)
+
+const (
+	msgInvalidCompanyID    = "invalid company ID"
+	msgInvalidAddressID    = "invalid address ID"
+	msgInvalidServiceID    = "service_id query parameter is required"
+	msgMissingDate         = "date query parameter is required"
+	msgInvalidBusy         = "invalid busy interval, expected HH:MM-HH:MM"
+	msgCompanyNotFound     = "company not found"
+	msgAddressNotFound     = "address not found"
+	msgServiceNotFound     = "service not found"
+	msgServiceNotAtAddress = "service is not available at this address"
+	msgServiceNoDuration   = "service has no average duration"
+)
+
+type Handler struct {
+	service SlotService
+	logger  Logger
+}
+
+func NewHandler(service SlotService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/companies/{company_id}/addresses/{address_id}/slots
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	companyIDStr := vars["company_id"]
+	addressIDStr := vars["address_id"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Invalid company ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		return
+	}
+
+	addressID, err := strconv.ParseInt(addressIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Invalid address ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidAddressID)
+		return
+	}
+
+	query := r.URL.Query()
+
+	var req models.SlotsRequest
+
+	req.ServiceID, err = strconv.ParseInt(query.Get("service_id"), 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Invalid service ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidServiceID)
+		return
+	}
+
+	req.Date = query.Get("date")
+	if req.Date == "" {
+		handlers.RespondBadRequest(w, msgMissingDate)
+		return
+	}
+
+	// Занятость от вызывающей стороны: busy=10:00-11:00, параметр можно повторять или перечислять через запятую
+	for _, value := range query["busy"] {
+		for _, item := range strings.Split(value, ",") {
+			start, end, ok := strings.Cut(strings.TrimSpace(item), "-")
+			if !ok {
+				h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Invalid busy interval: %q", item)
+				handlers.RespondBadRequest(w, msgInvalidBusy)
+				return
+			}
+			req.Busy = append(req.Busy, models.IntervalRequest{Start: start, End: end})
+		}
+	}
+
+	response, err := h.service.GetSlots(r.Context(), companyID, addressID, &req)
+	if err != nil {
+		if errors.Is(err, slots.ErrInvalidInput) {
+			h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Invalid input: company_id=%d, address_id=%d, error=%v", companyID, addressID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
+		if errors.Is(err, slots.ErrCompanyNotFound) {
+			h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Company not found: company_id=%d", companyID)
+			handlers.RespondNotFound(w, msgCompanyNotFound)
+			return
+		}
+		if errors.Is(err, slots.ErrAddressNotFound) {
+			h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Address not found: company_id=%d, address_id=%d", companyID, addressID)
+			handlers.RespondNotFound(w, msgAddressNotFound)
+			return
+		}
+		if errors.Is(err, slots.ErrServiceNotFound) {
+			h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Service not found: company_id=%d, service_id=%d", companyID, req.ServiceID)
+			handlers.RespondNotFound(w, msgServiceNotFound)
+			return
+		}
+		if errors.Is(err, slots.ErrServiceNotAtAddress) {
+			h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Service not available at address: address_id=%d, service_id=%d", addressID, req.ServiceID)
+			handlers.RespondUnprocessableEntity(w, msgServiceNotAtAddress, nil)
+			return
+		}
+		if errors.Is(err, slots.ErrDurationUnknown) {
+			h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/slots - Service has no duration: service_id=%d", req.ServiceID)
+			handlers.RespondUnprocessableEntity(w, msgServiceNoDuration, nil)
+			return
+		}
+		h.logger.Error("GET /companies/{company_id}/addresses/{address_id}/slots - Failed to get slots: company_id=%d, address_id=%d, service_id=%d, error=%v", companyID, addressID, req.ServiceID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("GET /companies/{company_id}/addresses/{address_id}/slots - Slots calculated successfully: company_id=%d, address_id=%d, service_id=%d, date=%s, count=%d", companyID, addressID, req.ServiceID, req.Date, len(response.Slots))
+	handlers.RespondJSON(w, http.StatusOK, response)
+}
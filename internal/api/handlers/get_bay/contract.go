@@ -0,0 +1,17 @@
+package get_bay
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+type BayService interface {
+	GetBay(ctx context.Context, companyID int64, addressID int64, bayID int64) (*models.BayResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
@@ -0,0 +1,80 @@
+package get_bay
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/service/services"
+)
+
+const (
+	msgInvalidCompanyID = "invalid company ID"
+	msgInvalidAddressID = "invalid address ID"
+	msgInvalidBayID     = "invalid bay ID"
+	msgAddressNotFound  = "address not found"
+	msgNotFound         = "bay not found"
+)
+
+type Handler struct {
+	service BayService
+	logger  Logger
+}
+
+func NewHandler(service BayService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/companies/{company_id}/addresses/{address_id}/bays/{bay_id}
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	companyIDStr := vars["company_id"]
+	addressIDStr := vars["address_id"]
+	bayIDStr := vars["bay_id"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Invalid company ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		return
+	}
+
+	addressID, err := strconv.ParseInt(addressIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Invalid address ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidAddressID)
+		return
+	}
+
+	bayID, err := strconv.ParseInt(bayIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Invalid bay ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidBayID)
+		return
+	}
+
+	bay, err := h.service.GetBay(r.Context(), companyID, addressID, bayID)
+	if err != nil {
+		if errors.Is(err, services.ErrAddressNotFound) {
+			h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Address not found: company_id=%d, address_id=%d", companyID, addressID)
+			handlers.RespondNotFound(w, msgAddressNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrBayNotFound) {
+			h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Bay not found: company_id=%d, address_id=%d, bay_id=%d", companyID, addressID, bayID)
+			handlers.RespondNotFound(w, msgNotFound)
+			return
+		}
+		h.logger.Error("GET /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Failed to get bay: company_id=%d, bay_id=%d, error=%v", companyID, bayID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("GET /companies/{company_id}/addresses/{address_id}/bays/{bay_id} - Bay retrieved successfully: company_id=%d, address_id=%d, bay_id=%d", companyID, addressID, bayID)
+	handlers.RespondJSON(w, http.StatusOK, bay)
+}
@@ -7,7 +7,9 @@ import (
 )
 
 type CompanyService interface {
-	GetByID(ctx context.Context, id int64) (*models.CompanyResponse, error)
+	GetByID(ctx context.Context, id int64, locale string) (*models.CompanyResponse, error)
+	GetPublicByID(ctx context.Context, id int64, locale string) (*models.CompanyPublicResponse, error)
+	CanViewPrivate(ctx context.Context, companyID int64, userID *int64, userRole string) (bool, error)
 }
 
 type Logger interface {
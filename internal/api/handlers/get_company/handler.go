@@ -7,6 +7,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
 	"github.com/m04kA/SMK-SellerService/internal/service/companies"
 )
 
@@ -39,7 +40,30 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	company, err := h.service.GetByID(r.Context(), id)
+	// Язык контента из Accept-Language; при отсутствии перевода отдаётся базовый текст
+	locale := handlers.PreferredLocale(r)
+
+	// Опциональный пользователь: менеджеры компании и роли с правом чтения любых компаний
+	// получают полное представление, остальные - публичное без менеджеров и служебных полей
+	var userID *int64
+	if ctxUserID, ok := middleware.GetUserID(r.Context()); ok && ctxUserID > 0 {
+		userID = &ctxUserID
+	}
+	userRole, _ := middleware.GetUserRole(r.Context())
+
+	canViewPrivate, err := h.service.CanViewPrivate(r.Context(), id, userID, userRole)
+	if err != nil {
+		h.logger.Error("GET /companies/{id} - Failed to check access: company_id=%d, error=%v", id, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	var company interface{}
+	if canViewPrivate {
+		company, err = h.service.GetByID(r.Context(), id, locale)
+	} else {
+		company, err = h.service.GetPublicByID(r.Context(), id, locale)
+	}
 	if err != nil {
 		if errors.Is(err, companies.ErrCompanyNotFound) {
 			h.logger.Warn("GET /companies/{id} - Company not found: company_id=%d", id)
@@ -51,6 +75,6 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.logger.Info("GET /companies/{id} - Company retrieved successfully: company_id=%d", id)
+	h.logger.Info("GET /companies/{id} - Company retrieved successfully: company_id=%d, private=%t", id, canViewPrivate)
 	handlers.RespondJSON(w, http.StatusOK, company)
 }
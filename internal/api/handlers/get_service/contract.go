@@ -2,12 +2,14 @@ package get_service
 
 import (
 	"context"
+	"time"
 
 	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
 )
 
 type ServiceService interface {
-	GetByID(ctx context.Context, companyID int64, serviceID int64, userID *int64) (*models.ServiceResponse, error)
+	GetByID(ctx context.Context, companyID int64, serviceID int64, userID *int64, userRole string, vehicleClass *string, locale string) (*models.ServiceResponse, error)
+	GetAsOf(ctx context.Context, companyID int64, serviceID int64, asOf time.Time, userID *int64, userRole string, locale string) (*models.ServiceResponse, error)
 }
 
 type Logger interface {
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
 	"github.com/m04kA/SMK-SellerService/internal/service/services"
 )
 
@@ -14,6 +16,9 @@ const (
 	msgInvalidCompanyID = "invalid company ID"
 	msgInvalidServiceID = "invalid service ID"
 	msgNotFound         = "service not found"
+	msgInvalidAsOf      = "invalid as_of parameter, expected RFC 3339 timestamp"
+	msgRevisionNotFound = "service did not exist at the requested time"
+	msgAsOfVehicleClass = "vehicle_class cannot be combined with as_of: prices are not calculated for past revisions"
 )
 
 type Handler struct {
@@ -48,17 +53,49 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Парсим опциональный заголовок X-User-ID для расчёта цен
+	// Опциональный пользователь для расчёта цен
 	var userID *int64
-	if userIDStr := r.Header.Get("X-User-ID"); userIDStr != "" {
-		parsedUserID, err := strconv.ParseInt(userIDStr, 10, 64)
-		if err == nil && parsedUserID > 0 {
-			userID = &parsedUserID
+	if ctxUserID, ok := middleware.GetUserID(r.Context()); ok && ctxUserID > 0 {
+		userID = &ctxUserID
+	}
+
+	// Опциональная роль: скрытые услуги видят менеджеры компании и роли с правом чтения услуг
+	userRole, _ := middleware.GetUserRole(r.Context())
+
+	// Язык контента из Accept-Language; при отсутствии перевода отдаётся базовый текст
+	locale := handlers.PreferredLocale(r)
+
+	// Класс автомобиля для расчёта цены (опционально), например для гостя без сохранённого автомобиля
+	var vehicleClass *string
+	if vehicleClassStr := r.URL.Query().Get("vehicle_class"); vehicleClassStr != "" {
+		vehicleClass = &vehicleClassStr
+	}
+
+	// Опциональный момент времени: услуга возвращается в том виде, в каком была тогда
+	if asOfStr := r.URL.Query().Get("as_of"); asOfStr != "" {
+		asOf, err := time.Parse(time.RFC3339, asOfStr)
+		if err != nil {
+			h.logger.Warn("GET /companies/{company_id}/services/{service_id} - Invalid as_of: %v", err)
+			handlers.RespondBadRequest(w, msgInvalidAsOf)
+			return
+		}
+		// Ревизия отдаётся без цен, поэтому класс автомобиля не на что применить
+		if vehicleClass != nil {
+			h.logger.Warn("GET /companies/{company_id}/services/{service_id} - vehicle_class with as_of: company_id=%d, service_id=%d", companyID, serviceID)
+			handlers.RespondBadRequest(w, msgAsOfVehicleClass)
+			return
 		}
+		h.handleAsOf(w, r, companyID, serviceID, asOf, userID, userRole, locale)
+		return
 	}
 
-	service, err := h.service.GetByID(r.Context(), companyID, serviceID, userID)
+	service, err := h.service.GetByID(r.Context(), companyID, serviceID, userID, userRole, vehicleClass, locale)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			h.logger.Warn("GET /companies/{company_id}/services/{service_id} - Invalid vehicle class: company_id=%d, service_id=%d, error=%v", companyID, serviceID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
 		if errors.Is(err, services.ErrServiceNotFound) {
 			h.logger.Warn("GET /companies/{company_id}/services/{service_id} - Service not found: company_id=%d, service_id=%d", companyID, serviceID)
 			handlers.RespondNotFound(w, msgNotFound)
@@ -74,5 +111,29 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	} else {
 		h.logger.Info("GET /companies/{company_id}/services/{service_id} - Service retrieved successfully: company_id=%d, service_id=%d", companyID, serviceID)
 	}
+	if service.Pricing != nil {
+		w.Header().Set(handlers.HeaderPricingStatus, service.Pricing.Status)
+	}
+	handlers.RespondJSON(w, http.StatusOK, service)
+}
+
+// handleAsOf отдаёт ревизию услуги, действовавшую на момент asOf
+func (h *Handler) handleAsOf(w http.ResponseWriter, r *http.Request, companyID int64, serviceID int64, asOf time.Time, userID *int64, userRole string, locale string) {
+	service, err := h.service.GetAsOf(r.Context(), companyID, serviceID, asOf, userID, userRole, locale)
+	if err != nil {
+		if errors.Is(err, services.ErrRevisionNotFound) {
+			h.logger.Warn("GET /companies/{company_id}/services/{service_id} - Revision not found: company_id=%d, service_id=%d, as_of=%s", companyID, serviceID, asOf.Format(time.RFC3339))
+			handlers.RespondNotFound(w, msgRevisionNotFound)
+			return
+		}
+		h.logger.Error("GET /companies/{company_id}/services/{service_id} - Failed to get service revision: company_id=%d, service_id=%d, as_of=%s, error=%v", companyID, serviceID, asOf.Format(time.RFC3339), err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("GET /companies/{company_id}/services/{service_id} - Service revision retrieved successfully: company_id=%d, service_id=%d, revision=%d", companyID, serviceID, *service.Revision)
+	if service.Pricing != nil {
+		w.Header().Set(handlers.HeaderPricingStatus, service.Pricing.Status)
+	}
 	handlers.RespondJSON(w, http.StatusOK, service)
 }
@@ -0,0 +1,17 @@
+package get_service_template
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/templates/models"
+)
+
+type TemplateService interface {
+	GetByID(ctx context.Context, id int64) (*models.TemplateResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
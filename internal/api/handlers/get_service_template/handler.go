@@ -0,0 +1,56 @@
+package get_service_template
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/service/templates"
+)
+
+const (
+	msgInvalidTemplateID = "invalid template ID"
+	msgNotFound          = "service template not found"
+)
+
+type Handler struct {
+	service TemplateService
+	logger  Logger
+}
+
+func NewHandler(service TemplateService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/service-templates/{template_id}
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	idStr := vars["template_id"]
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /service-templates/{template_id} - Invalid template ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidTemplateID)
+		return
+	}
+
+	template, err := h.service.GetByID(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, templates.ErrTemplateNotFound) {
+			h.logger.Warn("GET /service-templates/{template_id} - Service template not found: template_id=%d", id)
+			handlers.RespondNotFound(w, msgNotFound)
+			return
+		}
+		h.logger.Error("GET /service-templates/{template_id} - Failed to get service template: template_id=%d, error=%v", id, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("GET /service-templates/{template_id} - Service template retrieved successfully: template_id=%d", id)
+	handlers.RespondJSON(w, http.StatusOK, template)
+}
@@ -0,0 +1,17 @@
+package list_bays
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+type BayService interface {
+	ListBays(ctx context.Context, companyID int64, addressID int64) (*models.BayListResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
@@ -0,0 +1,65 @@
+package list_bays
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/service/services"
+)
+
+const (
+	msgInvalidCompanyID = "invalid company ID"
+	msgInvalidAddressID = "invalid address ID"
+	msgAddressNotFound  = "address not found"
+)
+
+type Handler struct {
+	service BayService
+	logger  Logger
+}
+
+func NewHandler(service BayService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/companies/{company_id}/addresses/{address_id}/bays
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	companyIDStr := vars["company_id"]
+	addressIDStr := vars["address_id"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/bays - Invalid company ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		return
+	}
+
+	addressID, err := strconv.ParseInt(addressIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/bays - Invalid address ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidAddressID)
+		return
+	}
+
+	bays, err := h.service.ListBays(r.Context(), companyID, addressID)
+	if err != nil {
+		if errors.Is(err, services.ErrAddressNotFound) {
+			h.logger.Warn("GET /companies/{company_id}/addresses/{address_id}/bays - Address not found: company_id=%d, address_id=%d", companyID, addressID)
+			handlers.RespondNotFound(w, msgAddressNotFound)
+			return
+		}
+		h.logger.Error("GET /companies/{company_id}/addresses/{address_id}/bays - Failed to list bays: company_id=%d, address_id=%d, error=%v", companyID, addressID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("GET /companies/{company_id}/addresses/{address_id}/bays - Bays retrieved successfully: company_id=%d, address_id=%d, count=%d", companyID, addressID, len(bays.Bays))
+	handlers.RespondJSON(w, http.StatusOK, bays)
+}
@@ -7,7 +7,9 @@ import (
 )
 
 type CompanyService interface {
-	List(ctx context.Context, req *models.CompanyFilterRequest) (*models.CompanyListResponse, error)
+	List(ctx context.Context, req *models.CompanyFilterRequest, locale string) (*models.CompanyListResponse, error)
+	ListPublic(ctx context.Context, req *models.CompanyFilterRequest, locale string) (*models.CompanyPublicListResponse, error)
+	CanListPrivate(userRole string) bool
 }
 
 type Logger interface {
@@ -1,19 +1,26 @@
 package list_companies
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/companies"
 	"github.com/m04kA/SMK-SellerService/internal/service/companies/models"
 )
 
 const (
 	msgInvalidPageParam  = "invalid page parameter"
 	msgInvalidLimitParam = "invalid limit parameter"
+	msgInvalidInclude    = "invalid include parameter, expected: price_from"
 )
 
+// includePriceFrom значение include для минимальной цены услуг компании
+const includePriceFrom = "price_from"
+
 type Handler struct {
 	service CompanyService
 	logger  Logger
@@ -47,6 +54,11 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		req.City = &city
 	}
 
+	// Парсим тип поста (опционально)
+	if bayType := query.Get("bay_type"); bayType != "" {
+		req.BayType = &bayType
+	}
+
 	// Парсим пагинацию (опционально)
 	if pageStr := query.Get("page"); pageStr != "" {
 		page, err := strconv.Atoi(pageStr)
@@ -68,13 +80,60 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		req.Limit = &limit
 	}
 
-	response, err := h.service.List(r.Context(), &req)
+	// Парсим дополнительные поля (опционально)
+	if includeStr := query.Get("include"); includeStr != "" {
+		for _, include := range strings.Split(includeStr, ",") {
+			switch strings.TrimSpace(include) {
+			case includePriceFrom:
+				req.IncludePriceFrom = true
+			default:
+				h.logger.Warn("GET /companies - Invalid include parameter: %s", include)
+				handlers.RespondBadRequest(w, msgInvalidInclude)
+				return
+			}
+		}
+	}
+
+	// Язык контента из Accept-Language; при отсутствии перевода отдаётся базовый текст
+	locale := handlers.PreferredLocale(r)
+
+	// Полное представление только для ролей с правом чтения любых компаний,
+	// остальные получают публичное без менеджеров и служебных полей
+	userRole, _ := middleware.GetUserRole(r.Context())
+	if h.service.CanListPrivate(userRole) {
+		response, err := h.service.List(r.Context(), &req, locale)
+		if err != nil {
+			h.respondError(w, err)
+			return
+		}
+		h.respond(w, response, len(response.Companies), response.Pricing, true)
+		return
+	}
+
+	response, err := h.service.ListPublic(r.Context(), &req, locale)
 	if err != nil {
-		h.logger.Error("GET /companies - Failed to list companies: error=%v", err)
-		handlers.RespondInternalError(w)
+		h.respondError(w, err)
 		return
 	}
+	h.respond(w, response, len(response.Companies), response.Pricing, false)
+}
 
-	h.logger.Info("GET /companies - Companies listed successfully: count=%d", len(response.Companies))
+// respondError отвечает ошибкой получения списка компаний
+func (h *Handler) respondError(w http.ResponseWriter, err error) {
+	if errors.Is(err, companies.ErrInvalidInput) {
+		h.logger.Warn("GET /companies - Invalid filter: %v", err)
+		handlers.RespondBadRequest(w, err.Error())
+		return
+	}
+	h.logger.Error("GET /companies - Failed to list companies: error=%v", err)
+	handlers.RespondInternalError(w)
+}
+
+// respond отвечает списком компаний в выбранном представлении
+func (h *Handler) respond(w http.ResponseWriter, response interface{}, count int, pricing *models.PricingInfo, private bool) {
+	h.logger.Info("GET /companies - Companies listed successfully: count=%d, private=%t", count, private)
+	if pricing != nil {
+		w.Header().Set(handlers.HeaderPricingStatus, pricing.Status)
+	}
 	handlers.RespondJSON(w, http.StatusOK, response)
 }
@@ -0,0 +1,17 @@
+package list_service_revisions
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+type ServiceService interface {
+	ListRevisions(ctx context.Context, companyID int64, serviceID int64, userID int64, userRole string) (*models.ServiceRevisionListResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
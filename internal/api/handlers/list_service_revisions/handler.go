@@ -0,0 +1,92 @@
+package list_service_revisions
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/services"
+)
+
+const (
+	msgInvalidCompanyID = "invalid company ID"
+	msgInvalidServiceID = "invalid service ID"
+	msgForbidden        = "access denied"
+	msgNotFound         = "service not found"
+	msgCompanyNotFound  = "company not found"
+	msgMissingUserID    = "missing user ID"
+	msgMissingUserRole  = "missing user role"
+)
+
+type Handler struct {
+	service ServiceService
+	logger  Logger
+}
+
+func NewHandler(service ServiceService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/companies/{company_id}/services/{service_id}/revisions
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	userRole, ok := middleware.GetUserRole(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserRole)
+		return
+	}
+
+	vars := mux.Vars(r)
+	companyIDStr := vars["company_id"]
+	serviceIDStr := vars["service_id"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/services/{service_id}/revisions - Invalid company ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		return
+	}
+
+	serviceID, err := strconv.ParseInt(serviceIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("GET /companies/{company_id}/services/{service_id}/revisions - Invalid service ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidServiceID)
+		return
+	}
+
+	response, err := h.service.ListRevisions(r.Context(), companyID, serviceID, userID, userRole)
+	if err != nil {
+		if errors.Is(err, services.ErrServiceNotFound) {
+			h.logger.Warn("GET /companies/{company_id}/services/{service_id}/revisions - Service not found: company_id=%d, service_id=%d", companyID, serviceID)
+			handlers.RespondNotFound(w, msgNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrCompanyNotFound) {
+			h.logger.Warn("GET /companies/{company_id}/services/{service_id}/revisions - Company not found: company_id=%d", companyID)
+			handlers.RespondNotFound(w, msgCompanyNotFound)
+			return
+		}
+		if errors.Is(err, services.ErrAccessDenied) {
+			h.logger.Warn("GET /companies/{company_id}/services/{service_id}/revisions - Access denied: company_id=%d, service_id=%d, user_id=%d", companyID, serviceID, userID)
+			handlers.RespondForbidden(w, msgForbidden)
+			return
+		}
+		h.logger.Error("GET /companies/{company_id}/services/{service_id}/revisions - Failed to list revisions: company_id=%d, service_id=%d, user_id=%d, error=%v", companyID, serviceID, userID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("GET /companies/{company_id}/services/{service_id}/revisions - Revisions retrieved successfully: company_id=%d, service_id=%d, count=%d, user_id=%d", companyID, serviceID, len(response.Revisions), userID)
+	handlers.RespondJSON(w, http.StatusOK, response)
+}
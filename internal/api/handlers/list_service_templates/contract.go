@@ -0,0 +1,17 @@
+package list_service_templates
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/templates/models"
+)
+
+type TemplateService interface {
+	List(ctx context.Context, req *models.TemplateFilterRequest) (*models.TemplateListResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
@@ -0,0 +1,40 @@
+package list_service_templates
+
+import (
+	"net/http"
+
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/service/templates/models"
+)
+
+type Handler struct {
+	service TemplateService
+	logger  Logger
+}
+
+func NewHandler(service TemplateService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle GET /api/v1/service-templates
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	var req models.TemplateFilterRequest
+
+	// Парсим категорию (опционально)
+	if category := r.URL.Query().Get("category"); category != "" {
+		req.Category = &category
+	}
+
+	response, err := h.service.List(r.Context(), &req)
+	if err != nil {
+		h.logger.Error("GET /service-templates - Failed to list service templates: error=%v", err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("GET /service-templates - Service templates listed successfully: count=%d", len(response.Templates))
+	handlers.RespondJSON(w, http.StatusOK, response)
+}
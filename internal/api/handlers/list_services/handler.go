@@ -1,15 +1,21 @@
 package list_services
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/gorilla/mux"
 	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/services"
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
 )
 
 const (
 	msgInvalidCompanyID = "invalid company ID"
+	msgInvalidMinPrice  = "invalid min_price parameter"
+	msgInvalidMaxPrice  = "invalid max_price parameter"
 )
 
 type Handler struct {
@@ -36,17 +42,56 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Парсим опциональный заголовок X-User-ID для расчёта цен
+	// Опциональный пользователь для расчёта цен
 	var userID *int64
-	if userIDStr := r.Header.Get("X-User-ID"); userIDStr != "" {
-		parsedUserID, err := strconv.ParseInt(userIDStr, 10, 64)
-		if err == nil && parsedUserID > 0 {
-			userID = &parsedUserID
+	if ctxUserID, ok := middleware.GetUserID(r.Context()); ok && ctxUserID > 0 {
+		userID = &ctxUserID
+	}
+
+	// Опциональная роль: скрытые услуги видят менеджеры компании и роли с правом чтения услуг
+	userRole, _ := middleware.GetUserRole(r.Context())
+
+	// Парсим фильтры
+	var req models.ServiceFilterRequest
+
+	// Класс автомобиля (опционально)
+	if vehicleClass := r.URL.Query().Get("vehicle_class"); vehicleClass != "" {
+		req.VehicleClass = &vehicleClass
+	}
+
+	// Фильтры и сортировка по цене (применяются после обогащения ценами)
+	if minPriceStr := r.URL.Query().Get("min_price"); minPriceStr != "" {
+		minPrice, err := strconv.ParseFloat(minPriceStr, 64)
+		if err != nil {
+			h.logger.Warn("GET /companies/{company_id}/services - Invalid min_price: %v", err)
+			handlers.RespondBadRequest(w, msgInvalidMinPrice)
+			return
 		}
+		req.MinPrice = &minPrice
+	}
+	if maxPriceStr := r.URL.Query().Get("max_price"); maxPriceStr != "" {
+		maxPrice, err := strconv.ParseFloat(maxPriceStr, 64)
+		if err != nil {
+			h.logger.Warn("GET /companies/{company_id}/services - Invalid max_price: %v", err)
+			handlers.RespondBadRequest(w, msgInvalidMaxPrice)
+			return
+		}
+		req.MaxPrice = &maxPrice
+	}
+	if sort := r.URL.Query().Get("sort"); sort != "" {
+		req.Sort = &sort
 	}
 
-	response, err := h.service.ListByCompany(r.Context(), companyID, userID)
+	// Язык контента из Accept-Language; при отсутствии перевода отдаётся базовый текст
+	locale := handlers.PreferredLocale(r)
+
+	response, err := h.service.ListByCompany(r.Context(), companyID, userID, userRole, &req, locale)
 	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			h.logger.Warn("GET /companies/{company_id}/services - Invalid filter: company_id=%d, error=%v", companyID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
 		h.logger.Error("GET /companies/{company_id}/services - Failed to list services: company_id=%d, error=%v", companyID, err)
 		handlers.RespondInternalError(w)
 		return
@@ -57,5 +102,8 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 	} else {
 		h.logger.Info("GET /companies/{company_id}/services - Services listed successfully: company_id=%d, count=%d", companyID, len(response.Services))
 	}
+	if response.Pricing != nil {
+		w.Header().Set(handlers.HeaderPricingStatus, response.Pricing.Status)
+	}
 	handlers.RespondJSON(w, http.StatusOK, response)
 }
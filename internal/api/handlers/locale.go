@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+)
+
+// PreferredLocale выбирает язык контента по заголовку Accept-Language
+// Учитываются веса q и основной подтег ("en-US" -> "en").
+// Возвращает пустую строку, если ни один поддерживаемый язык не запрошен - тогда отдаётся базовый текст
+func PreferredLocale(r *http.Request) string {
+	header := r.Header.Get("Accept-Language")
+	if header == "" {
+		return ""
+	}
+
+	best := ""
+	bestQ := 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		primary, _, _ := strings.Cut(tag, "-")
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+
+		// При равных весах побеждает язык, указанный раньше
+		if q > bestQ && domain.Locale(primary).IsValid() {
+			best = primary
+			bestQ = q
+		}
+	}
+
+	return best
+}
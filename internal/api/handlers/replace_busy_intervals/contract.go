@@ -0,0 +1,17 @@
+package replace_busy_intervals
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/slots/models"
+)
+
+type SlotService interface {
+	ReplaceBusyIntervals(ctx context.Context, companyID int64, addressID int64, userID int64, userRole string, req *models.BusyIntervalsRequest) (*models.BusyIntervalsResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
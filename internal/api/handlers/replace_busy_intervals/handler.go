@@ -0,0 +1,106 @@
+package replace_busy_intervals
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/slots"
+	"github.com/m04kA/SMK-SellerService/internal/service/slots/models"
+)
+
+const (
+	msgInvalidRequestBody = "invalid request body"
+	msgInvalidCompanyID   = "invalid company ID"
+	msgInvalidAddressID   = "invalid address ID"
+	msgForbidden          = "access denied"
+	msgCompanyNotFound    = "company not found"
+	msgAddressNotFound    = "address not found"
+	msgMissingUserID      = "missing user ID"
+	msgMissingUserRole    = "missing user role"
+)
+
+type Handler struct {
+	service SlotService
+	logger  Logger
+}
+
+func NewHandler(service SlotService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle PUT /api/v1/companies/{company_id}/addresses/{address_id}/busy-intervals
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	userRole, ok := middleware.GetUserRole(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserRole)
+		return
+	}
+
+	vars := mux.Vars(r)
+	companyIDStr := vars["company_id"]
+	addressIDStr := vars["address_id"]
+
+	companyID, err := strconv.ParseInt(companyIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("PUT /companies/{company_id}/addresses/{address_id}/busy-intervals - Invalid company ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidCompanyID)
+		return
+	}
+
+	addressID, err := strconv.ParseInt(addressIDStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("PUT /companies/{company_id}/addresses/{address_id}/busy-intervals - Invalid address ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidAddressID)
+		return
+	}
+
+	var req models.BusyIntervalsRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.Warn("PUT /companies/{company_id}/addresses/{address_id}/busy-intervals - Invalid request body: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidRequestBody)
+		return
+	}
+
+	response, err := h.service.ReplaceBusyIntervals(r.Context(), companyID, addressID, userID, userRole, &req)
+	if err != nil {
+		if errors.Is(err, slots.ErrCompanyNotFound) {
+			h.logger.Warn("PUT /companies/{company_id}/addresses/{address_id}/busy-intervals - Company not found: company_id=%d", companyID)
+			handlers.RespondNotFound(w, msgCompanyNotFound)
+			return
+		}
+		if errors.Is(err, slots.ErrAccessDenied) {
+			h.logger.Warn("PUT /companies/{company_id}/addresses/{address_id}/busy-intervals - Access denied: company_id=%d, user_id=%d", companyID, userID)
+			handlers.RespondForbidden(w, msgForbidden)
+			return
+		}
+		if errors.Is(err, slots.ErrInvalidInput) {
+			h.logger.Warn("PUT /companies/{company_id}/addresses/{address_id}/busy-intervals - Invalid input: company_id=%d, address_id=%d, error=%v", companyID, addressID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
+		if errors.Is(err, slots.ErrAddressNotFound) {
+			h.logger.Warn("PUT /companies/{company_id}/addresses/{address_id}/busy-intervals - Address not found: company_id=%d, address_id=%d", companyID, addressID)
+			handlers.RespondNotFound(w, msgAddressNotFound)
+			return
+		}
+		h.logger.Error("PUT /companies/{company_id}/addresses/{address_id}/busy-intervals - Failed to replace busy intervals: company_id=%d, address_id=%d, user_id=%d, error=%v", companyID, addressID, userID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("PUT /companies/{company_id}/addresses/{address_id}/busy-intervals - Busy intervals replaced successfully: company_id=%d, address_id=%d, date=%s, count=%d, user_id=%d", companyID, addressID, req.Date, len(response.Intervals), userID)
+	handlers.RespondJSON(w, http.StatusOK, response)
+}
@@ -76,6 +76,11 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 			handlers.RespondForbidden(w, msgForbidden)
 			return
 		}
+		if errors.Is(err, companies.ErrInvalidInput) {
+			h.logger.Warn("PUT /companies/{id} - Invalid input: company_id=%d, error=%v", id, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
 		h.logger.Error("PUT /companies/{id} - Failed to update company: company_id=%d, user_id=%d, error=%v", id, userID, err)
 		handlers.RespondInternalError(w)
 		return
@@ -19,6 +19,7 @@ const (
 	msgForbidden          = "access denied"
 	msgNotFound           = "service not found"
 	msgCompanyNotFound    = "company not found"
+	msgAddressNotOwned    = "addresses do not belong to company"
 	msgMissingUserID      = "missing user ID"
 	msgMissingUserRole    = "missing user role"
 )
@@ -86,6 +87,19 @@ func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
 			handlers.RespondNotFound(w, msgCompanyNotFound)
 			return
 		}
+		if errors.Is(err, services.ErrInvalidInput) {
+			h.logger.Warn("PUT /companies/{company_id}/services/{service_id} - Invalid input: service_id=%d, error=%v", serviceID, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
+		var ownershipErr *services.AddressOwnershipError
+		if errors.As(err, &ownershipErr) {
+			h.logger.Warn("PUT /companies/{company_id}/services/{service_id} - Address not owned by company: company_id=%d, address_ids=%v", companyID, ownershipErr.AddressIDs)
+			handlers.RespondUnprocessableEntity(w, msgAddressNotOwned, map[string]interface{}{
+				"address_ids": ownershipErr.AddressIDs,
+			})
+			return
+		}
 		if errors.Is(err, services.ErrAccessDenied) {
 			h.logger.Warn("PUT /companies/{company_id}/services/{service_id} - Access denied: company_id=%d, service_id=%d, user_id=%d", companyID, serviceID, userID)
 			handlers.RespondForbidden(w, msgForbidden)
@@ -0,0 +1,17 @@
+package update_service_template
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/templates/models"
+)
+
+type TemplateService interface {
+	Update(ctx context.Context, id int64, userID int64, userRole string, req *models.UpdateTemplateRequest) (*models.TemplateResponse, error)
+}
+
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
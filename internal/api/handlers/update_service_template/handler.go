@@ -0,0 +1,91 @@
+package update_service_template
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/m04kA/SMK-SellerService/internal/api/handlers"
+	"github.com/m04kA/SMK-SellerService/internal/api/middleware"
+	"github.com/m04kA/SMK-SellerService/internal/service/templates"
+	"github.com/m04kA/SMK-SellerService/internal/service/templates/models"
+)
+
+const (
+	msgInvalidRequestBody = "invalid request body"
+	msgInvalidTemplateID  = "invalid template ID"
+	msgForbidden          = "access denied"
+	msgNotFound           = "service template not found"
+	msgMissingUserID      = "missing user ID"
+	msgMissingUserRole    = "missing user role"
+)
+
+type Handler struct {
+	service TemplateService
+	logger  Logger
+}
+
+func NewHandler(service TemplateService, logger Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Handle PUT /api/v1/service-templates/{template_id}
+func (h *Handler) Handle(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserID)
+		return
+	}
+
+	userRole, ok := middleware.GetUserRole(r.Context())
+	if !ok {
+		handlers.RespondUnauthorized(w, msgMissingUserRole)
+		return
+	}
+
+	vars := mux.Vars(r)
+	idStr := vars["template_id"]
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		h.logger.Warn("PUT /service-templates/{template_id} - Invalid template ID: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidTemplateID)
+		return
+	}
+
+	var req models.UpdateTemplateRequest
+	if err := handlers.DecodeJSON(r, &req); err != nil {
+		h.logger.Warn("PUT /service-templates/{template_id} - Invalid request body: %v", err)
+		handlers.RespondBadRequest(w, msgInvalidRequestBody)
+		return
+	}
+
+	template, err := h.service.Update(r.Context(), id, userID, userRole, &req)
+	if err != nil {
+		if errors.Is(err, templates.ErrAccessDenied) {
+			h.logger.Warn("PUT /service-templates/{template_id} - Access denied: template_id=%d, user_id=%d, role=%s", id, userID, userRole)
+			handlers.RespondForbidden(w, msgForbidden)
+			return
+		}
+		if errors.Is(err, templates.ErrInvalidInput) {
+			h.logger.Warn("PUT /service-templates/{template_id} - Invalid input: template_id=%d, error=%v", id, err)
+			handlers.RespondBadRequest(w, err.Error())
+			return
+		}
+		if errors.Is(err, templates.ErrTemplateNotFound) {
+			h.logger.Warn("PUT /service-templates/{template_id} - Service template not found: template_id=%d", id)
+			handlers.RespondNotFound(w, msgNotFound)
+			return
+		}
+		h.logger.Error("PUT /service-templates/{template_id} - Failed to update service template: template_id=%d, user_id=%d, error=%v", id, userID, err)
+		handlers.RespondInternalError(w)
+		return
+	}
+
+	h.logger.Info("PUT /service-templates/{template_id} - Service template updated successfully: template_id=%d, user_id=%d", id, userID)
+	handlers.RespondJSON(w, http.StatusOK, template)
+}
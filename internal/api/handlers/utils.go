@@ -5,10 +5,14 @@ import (
 	"net/http"
 )
 
+// HeaderPricingStatus заголовок со статусом обогащения ценами (ok, degraded, not_found, skipped)
+const HeaderPricingStatus = "X-Pricing-Status"
+
 // ErrorResponse структура для ответа с ошибкой
 type ErrorResponse struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
+	Code    int                    `json:"code"`
+	Message string                 `json:"message"`
+	Details map[string]interface{} `json:"details,omitempty"`
 }
 
 // RespondJSON отправляет JSON ответ
@@ -28,6 +32,15 @@ func RespondError(w http.ResponseWriter, status int, message string) {
 	})
 }
 
+// RespondErrorWithDetails отправляет ошибку в формате JSON с дополнительными деталями
+func RespondErrorWithDetails(w http.ResponseWriter, status int, message string, details map[string]interface{}) {
+	RespondJSON(w, status, ErrorResponse{
+		Code:    status,
+		Message: message,
+		Details: details,
+	})
+}
+
 // DecodeJSON парсит JSON из request body
 func DecodeJSON(r *http.Request, v interface{}) error {
 	return json.NewDecoder(r.Body).Decode(v)
@@ -53,6 +66,11 @@ func RespondNotFound(w http.ResponseWriter, message string) {
 	RespondError(w, http.StatusNotFound, message)
 }
 
+// RespondUnprocessableEntity отправляет ошибку 422 с деталями
+func RespondUnprocessableEntity(w http.ResponseWriter, message string, details map[string]interface{}) {
+	RespondErrorWithDetails(w, http.StatusUnprocessableEntity, message, details)
+}
+
 // RespondInternalError отправляет ошибку 500
 func RespondInternalError(w http.ResponseWriter) {
 	RespondError(w, http.StatusInternalServerError, "internal server error")
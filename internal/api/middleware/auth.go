@@ -2,8 +2,13 @@ package middleware
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+
+	"github.com/m04kA/SMK-SellerService/internal/requestctx"
+	"github.com/m04kA/SMK-SellerService/internal/service"
 )
 
 type contextKey string
@@ -11,29 +16,88 @@ type contextKey string
 const (
 	UserIDKey   contextKey = "user_id"
 	UserRoleKey contextKey = "user_role"
+	authErrKey  contextKey = "auth_error"
 )
 
-// Auth извлекает заголовки аутентификации и сохраняет их в контекст
+var (
+	// ErrInvalidUserID возвращается, если X-User-ID не является числом
+	ErrInvalidUserID = errors.New("invalid user ID")
+
+	// ErrInvalidToken возвращается при отсутствующей, неверной или просроченной подписи JWT
+	ErrInvalidToken = errors.New("invalid token")
+
+	// ErrUnknownRole возвращается, если роль не входит в модель ролей
+	ErrUnknownRole = errors.New("unknown role")
+)
+
+// Identity пользователь, от имени которого выполняется запрос
+type Identity struct {
+	UserID int64  // 0 - не передан
+	Role   string // Пустая строка - не передана
+}
+
+// Authenticator определяет пользователя по запросу
+type Authenticator interface {
+	// Authenticate возвращает nil без ошибки, если учётные данные не переданы
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+// Identify определяет пользователя для всех маршрутов API и сохраняет его в контекст
+// Публичные маршруты работают и без пользователя: при отсутствии или ошибке учётных данных,
+// а также при неизвестной роли запрос продолжается анонимно, а ошибка сохраняется для Auth
+// на защищённых маршрутах.
+// Роль также передаётся в исходящие вызовы PriceService
+func Identify(authn Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			identity, err := authn.Authenticate(r)
+			if err == nil && identity != nil && identity.Role != "" && !service.IsKnownRole(identity.Role) {
+				identity, err = nil, fmt.Errorf("%w: %q", ErrUnknownRole, identity.Role)
+			}
+
+			ctx := r.Context()
+			if err != nil {
+				ctx = context.WithValue(ctx, authErrKey, err)
+			} else if identity != nil {
+				if identity.UserID != 0 {
+					ctx = context.WithValue(ctx, UserIDKey, identity.UserID)
+				}
+				if identity.Role != "" {
+					ctx = context.WithValue(ctx, UserRoleKey, identity.Role)
+					ctx = requestctx.WithUserRole(ctx, identity.Role)
+				}
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Auth пропускает только запросы, для которых Identify определил пользователя и его роль
+// Неизвестная роль отклоняется с 403
 func Auth(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		userIDStr := r.Header.Get("X-User-ID")
-		userRole := r.Header.Get("X-User-Role")
-
-		if userIDStr == "" || userRole == "" {
-			http.Error(w, "missing authentication headers", http.StatusUnauthorized)
+		if err, ok := r.Context().Value(authErrKey).(error); ok {
+			if errors.Is(err, ErrInvalidUserID) {
+				http.Error(w, ErrInvalidUserID.Error(), http.StatusBadRequest)
+				return
+			}
+			if errors.Is(err, ErrUnknownRole) {
+				http.Error(w, ErrUnknownRole.Error(), http.StatusForbidden)
+				return
+			}
+			http.Error(w, ErrInvalidToken.Error(), http.StatusUnauthorized)
 			return
 		}
 
-		userID, err := strconv.ParseInt(userIDStr, 10, 64)
-		if err != nil {
-			http.Error(w, "invalid user ID", http.StatusBadRequest)
+		_, hasUserID := GetUserID(r.Context())
+		_, hasUserRole := GetUserRole(r.Context())
+		if !hasUserID || !hasUserRole {
+			http.Error(w, "missing authentication credentials", http.StatusUnauthorized)
 			return
 		}
 
-		ctx := context.WithValue(r.Context(), UserIDKey, userID)
-		ctx = context.WithValue(ctx, UserRoleKey, userRole)
-
-		next.ServeHTTP(w, r.WithContext(ctx))
+		next.ServeHTTP(w, r)
 	})
 }
 
@@ -48,3 +112,33 @@ func GetUserRole(ctx context.Context) (string, bool) {
 	userRole, ok := ctx.Value(UserRoleKey).(string)
 	return userRole, ok
 }
+
+// HeaderAuthenticator доверяет заголовкам X-User-ID и X-User-Role
+// Подходит только для развёртывания за доверенным шлюзом, который сам проверяет пользователя
+// и перезаписывает эти заголовки
+type HeaderAuthenticator struct{}
+
+// NewHeaderAuthenticator создает новый экземпляр HeaderAuthenticator
+func NewHeaderAuthenticator() *HeaderAuthenticator {
+	return &HeaderAuthenticator{}
+}
+
+// Authenticate читает пользователя из заголовков
+func (a *HeaderAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	userIDStr := r.Header.Get("X-User-ID")
+	userRole := r.Header.Get("X-User-Role")
+	if userIDStr == "" && userRole == "" {
+		return nil, nil
+	}
+
+	identity := &Identity{Role: userRole}
+	if userIDStr != "" {
+		userID, err := strconv.ParseInt(userIDStr, 10, 64)
+		if err != nil {
+			return nil, ErrInvalidUserID
+		}
+		identity.UserID = userID
+	}
+
+	return identity, nil
+}
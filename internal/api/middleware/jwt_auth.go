@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/m04kA/SMK-SellerService/pkg/jwt"
+)
+
+// JWTAuthenticator проверяет токен из заголовка Authorization: Bearer
+// и берёт пользователя и роль из его claims
+type JWTAuthenticator struct {
+	verifier    *jwt.Verifier
+	userIDClaim string
+	roleClaim   string
+}
+
+// NewJWTAuthenticator создает новый экземпляр JWTAuthenticator
+func NewJWTAuthenticator(verifier *jwt.Verifier, userIDClaim string, roleClaim string) *JWTAuthenticator {
+	return &JWTAuthenticator{
+		verifier:    verifier,
+		userIDClaim: userIDClaim,
+		roleClaim:   roleClaim,
+	}
+}
+
+// Authenticate проверяет Bearer токен
+// Заголовки X-User-ID и X-User-Role в этом режиме игнорируются
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		return nil, nil
+	}
+
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+		return nil, fmt.Errorf("%w: expected Bearer token", ErrInvalidToken)
+	}
+
+	claims, err := a.verifier.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	userID, ok := claimInt64(claims[a.userIDClaim])
+	if !ok {
+		return nil, fmt.Errorf("%w: claim %q must be a user ID", ErrInvalidToken, a.userIDClaim)
+	}
+	role, _ := claims[a.roleClaim].(string)
+
+	return &Identity{UserID: userID, Role: role}, nil
+}
+
+// claimInt64 читает ID пользователя из claim: строка (как sub) или целое число
+func claimInt64(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case string:
+		id, err := strconv.ParseInt(v, 10, 64)
+		return id, err == nil && id != 0
+	case float64:
+		if v != math.Trunc(v) || v == 0 || math.Abs(v) > 1<<53 {
+			return 0, false
+		}
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
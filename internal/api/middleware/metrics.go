@@ -69,6 +69,8 @@ func categorizeError(statusCode int) string {
 		return "not_found"
 	case statusCode == 409:
 		return "conflict"
+	case statusCode == 422:
+		return "unprocessable_entity"
 	case statusCode >= 400 && statusCode < 500:
 		return "client_error"
 	case statusCode == 500:
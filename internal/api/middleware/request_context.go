@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/m04kA/SMK-SellerService/internal/requestctx"
+)
+
+// HeaderRequestID заголовок ID запроса для корреляции логов между сервисами
+const HeaderRequestID = "X-Request-ID"
+
+// maxRequestIDLength ограничивает длину ID, пришедшего от клиента
+const maxRequestIDLength = 128
+
+// RequestContext сохраняет в контекст ID запроса для исходящих вызовов
+// ID берётся из X-Request-ID или генерируется и возвращается в ответе.
+// Роль пользователя добавляет Identify после проверки учётных данных
+func RequestContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(HeaderRequestID)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = newRequestID()
+		}
+		w.Header().Set(HeaderRequestID, requestID)
+
+		ctx := requestctx.WithCorrelationID(r.Context(), requestID)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// newRequestID генерирует случайный ID запроса
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
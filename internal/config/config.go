@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 )
@@ -15,6 +16,8 @@ type Config struct {
 	Database     DatabaseConfig     `toml:"database"`
 	Metrics      MetricsConfig      `toml:"metrics"`
 	PriceService PriceServiceConfig `toml:"priceservice"`
+	Slots        SlotsConfig        `toml:"slots"`
+	Auth         AuthConfig         `toml:"auth"`
 }
 
 // LogsConfig содержит настройки логирования
@@ -54,7 +57,65 @@ type MetricsConfig struct {
 
 // PriceServiceConfig содержит настройки интеграции с PriceService
 type PriceServiceConfig struct {
-	BaseURL string `toml:"base_url"`
+	BaseURL          string `toml:"base_url"`
+	TimeoutMs        int    `toml:"timeout_ms"`          // Таймаут одной попытки запроса (миллисекунды)
+	EnrichBudgetMs   int    `toml:"enrich_budget_ms"`    // Сколько ответ каталога ждёт цены (миллисекунды), -1 - без ограничения
+	MaxAttempts      int    `toml:"max_attempts"`        // Всего попыток запроса, включая первую
+	RetryBaseDelayMs int    `toml:"retry_base_delay_ms"` // Задержка перед первым повтором (миллисекунды)
+	RetryMaxDelayMs  int    `toml:"retry_max_delay_ms"`  // Максимальная задержка между попытками (миллисекунды)
+
+	BackgroundTimeoutMs int `toml:"background_timeout_ms"` // Предел запроса цен, продолжающегося в фоне после enrich_budget_ms (миллисекунды)
+
+	BreakerFailureThreshold int `toml:"breaker_failure_threshold"`   // Ошибок подряд до открытия circuit breaker
+	BreakerCoolDownMs       int `toml:"breaker_cooldown_ms"`         // Время в открытом состоянии (миллисекунды)
+	BreakerHalfOpenMaxCalls int `toml:"breaker_half_open_max_calls"` // Пробных запросов одновременно в half-open
+
+	CacheTTLMs      int `toml:"cache_ttl_ms"`      // Время жизни цены в кэше (миллисекунды)
+	CacheMaxEntries int `toml:"cache_max_entries"` // Максимум цен в кэше
+
+	MaxIdleConns      int `toml:"max_idle_conns"`       // Максимум idle соединений с PriceService
+	MaxConnsPerHost   int `toml:"max_conns_per_host"`   // Максимум соединений с PriceService
+	IdleConnTimeoutMs int `toml:"idle_conn_timeout_ms"` // Время жизни idle соединения (миллисекунды)
+
+	BatchConcurrency int `toml:"batch_concurrency"` // Одновременных запросов цен при обогащении списка компаний
+
+	ChunkSize        int `toml:"chunk_size"`        // Максимум услуг в одном запросе к PriceService
+	ChunkConcurrency int `toml:"chunk_concurrency"` // Одновременных запросов частей одного расчёта
+
+	BaseURLs           []string `toml:"base_urls"`            // Несколько экземпляров PriceService, приоритетнее base_url
+	Balancing          string   `toml:"balancing"`            // Выбор endpoint: round_robin или least_loaded
+	EjectAfterFailures int      `toml:"eject_after_failures"` // Ошибок подряд до исключения endpoint
+	EjectDurationMs    int      `toml:"eject_duration_ms"`    // Время исключения endpoint (миллисекунды)
+	HedgeDelayMs       int      `toml:"hedge_delay_ms"`       // Дублирование запроса на другой endpoint (миллисекунды), 0 - выключено
+
+	AuthMode   string `toml:"auth_mode"`   // Учётные данные для PriceService: none, bearer или hmac
+	CallerID   string `toml:"caller_id"`   // Имя сервиса в заголовке X-Caller-ID
+	AuthToken  string `toml:"auth_token"`  // Токен для auth_mode=bearer (лучше задавать через PRICESERVICE_AUTH_TOKEN)
+	HMACSecret string `toml:"hmac_secret"` // Секрет для auth_mode=hmac (лучше задавать через PRICESERVICE_HMAC_SECRET)
+
+	SyncPollIntervalMs   int `toml:"sync_poll_interval_ms"`    // Период опроса outbox событий услуг (миллисекунды)
+	SyncBatchSize        int `toml:"sync_batch_size"`          // Событий услуг за один опрос outbox
+	SyncRetryBaseDelayMs int `toml:"sync_retry_base_delay_ms"` // Задержка перед первым повтором доставки события (миллисекунды)
+	SyncRetryMaxDelayMs  int `toml:"sync_retry_max_delay_ms"`  // Максимальная задержка между повторами доставки события (миллисекунды)
+}
+
+// SlotsConfig содержит настройки расчёта сетки слотов
+type SlotsConfig struct {
+	StepMinutes int `toml:"step_minutes"`
+}
+
+// AuthConfig содержит настройки аутентификации пользователей
+type AuthConfig struct {
+	Mode string `toml:"mode"` // header - доверять X-User-ID/X-User-Role (за доверенным шлюзом), jwt - проверять Bearer токен
+
+	JWTSecret           string `toml:"jwt_secret"`             // Секрет HS256 (лучше задавать через AUTH_JWT_SECRET)
+	JWKSFile            string `toml:"jwks_file"`              // JWKS файл с ключами RS256/ES256, перечитывается при изменении
+	JWKSCheckIntervalMs int    `toml:"jwks_check_interval_ms"` // Как часто проверять изменение JWKS файла (миллисекунды)
+	Issuer              string `toml:"issuer"`                 // Ожидаемый iss, пустой - не проверяется
+	Audience            string `toml:"audience"`               // Ожидаемый aud, пустой - не проверяется
+	LeewayMs            int    `toml:"leeway_ms"`              // Допустимое расхождение часов для exp/nbf (миллисекунды)
+	UserIDClaim         string `toml:"user_id_claim"`          // Claim с ID пользователя
+	RoleClaim           string `toml:"role_claim"`             // Claim с ролью пользователя
 }
 
 // DSN формирует строку подключения к PostgreSQL
@@ -141,6 +202,127 @@ func overrideFromEnv(cfg *Config) {
 	if v := os.Getenv("PRICESERVICE_BASE_URL"); v != "" {
 		cfg.PriceService.BaseURL = v
 	}
+	if v := os.Getenv("PRICESERVICE_BASE_URLS"); v != "" {
+		cfg.PriceService.BaseURLs = strings.Split(v, ",")
+	}
+	if v := os.Getenv("PRICESERVICE_AUTH_MODE"); v != "" {
+		cfg.PriceService.AuthMode = v
+	}
+	if v := os.Getenv("PRICESERVICE_AUTH_TOKEN"); v != "" {
+		cfg.PriceService.AuthToken = v
+	}
+	if v := os.Getenv("PRICESERVICE_HMAC_SECRET"); v != "" {
+		cfg.PriceService.HMACSecret = v
+	}
+	if v := os.Getenv("PRICESERVICE_SYNC_POLL_INTERVAL_MS"); v != "" {
+		if interval, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.SyncPollIntervalMs = interval
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_SYNC_BATCH_SIZE"); v != "" {
+		if size, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.SyncBatchSize = size
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_SYNC_RETRY_MAX_DELAY_MS"); v != "" {
+		if delay, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.SyncRetryMaxDelayMs = delay
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_HEDGE_DELAY_MS"); v != "" {
+		if delay, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.HedgeDelayMs = delay
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_TIMEOUT_MS"); v != "" {
+		if timeout, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.TimeoutMs = timeout
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_ENRICH_BUDGET_MS"); v != "" {
+		if budget, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.EnrichBudgetMs = budget
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_BACKGROUND_TIMEOUT_MS"); v != "" {
+		if timeout, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.BackgroundTimeoutMs = timeout
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_MAX_ATTEMPTS"); v != "" {
+		if attempts, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.MaxAttempts = attempts
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_RETRY_BASE_DELAY_MS"); v != "" {
+		if delay, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.RetryBaseDelayMs = delay
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_RETRY_MAX_DELAY_MS"); v != "" {
+		if delay, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.RetryMaxDelayMs = delay
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_BREAKER_FAILURE_THRESHOLD"); v != "" {
+		if threshold, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.BreakerFailureThreshold = threshold
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_BREAKER_COOLDOWN_MS"); v != "" {
+		if coolDown, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.BreakerCoolDownMs = coolDown
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_CACHE_TTL_MS"); v != "" {
+		if ttl, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.CacheTTLMs = ttl
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_CACHE_MAX_ENTRIES"); v != "" {
+		if entries, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.CacheMaxEntries = entries
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_BATCH_CONCURRENCY"); v != "" {
+		if concurrency, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.BatchConcurrency = concurrency
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_CHUNK_SIZE"); v != "" {
+		if size, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.ChunkSize = size
+		}
+	}
+	if v := os.Getenv("PRICESERVICE_CHUNK_CONCURRENCY"); v != "" {
+		if concurrency, err := strconv.Atoi(v); err == nil {
+			cfg.PriceService.ChunkConcurrency = concurrency
+		}
+	}
+
+	// Slots
+	if v := os.Getenv("SLOTS_STEP_MINUTES"); v != "" {
+		if step, err := strconv.Atoi(v); err == nil {
+			cfg.Slots.StepMinutes = step
+		}
+	}
+
+	// Auth
+	if v := os.Getenv("AUTH_MODE"); v != "" {
+		cfg.Auth.Mode = v
+	}
+	if v := os.Getenv("AUTH_JWT_SECRET"); v != "" {
+		cfg.Auth.JWTSecret = v
+	}
+	if v := os.Getenv("AUTH_JWKS_FILE"); v != "" {
+		cfg.Auth.JWKSFile = v
+	}
+	if v := os.Getenv("AUTH_ISSUER"); v != "" {
+		cfg.Auth.Issuer = v
+	}
+	if v := os.Getenv("AUTH_AUDIENCE"); v != "" {
+		cfg.Auth.Audience = v
+	}
 }
 
 // validate проверяет корректность конфигурации
@@ -206,8 +388,181 @@ func validate(cfg *Config) error {
 	}
 
 	// PriceService validation
-	if cfg.PriceService.BaseURL == "" {
-		return fmt.Errorf("priceservice base_url is required")
+	for i := range cfg.PriceService.BaseURLs {
+		cfg.PriceService.BaseURLs[i] = strings.TrimSpace(cfg.PriceService.BaseURLs[i])
+		if cfg.PriceService.BaseURLs[i] == "" {
+			return fmt.Errorf("priceservice base_urls must not contain empty values")
+		}
+	}
+	if len(cfg.PriceService.BaseURLs) == 0 {
+		if cfg.PriceService.BaseURL == "" {
+			return fmt.Errorf("priceservice base_url or base_urls is required")
+		}
+		cfg.PriceService.BaseURLs = []string{cfg.PriceService.BaseURL}
+	}
+	if cfg.PriceService.Balancing == "" {
+		cfg.PriceService.Balancing = "round_robin"
+	}
+	if cfg.PriceService.Balancing != "round_robin" && cfg.PriceService.Balancing != "least_loaded" {
+		return fmt.Errorf("priceservice balancing must be round_robin or least_loaded, got %q", cfg.PriceService.Balancing)
+	}
+	if cfg.PriceService.AuthMode == "" {
+		cfg.PriceService.AuthMode = "none"
+	}
+	if cfg.PriceService.CallerID == "" {
+		cfg.PriceService.CallerID = "sellerservice"
+	}
+	switch cfg.PriceService.AuthMode {
+	case "none":
+	case "bearer":
+		if cfg.PriceService.AuthToken == "" {
+			return fmt.Errorf("priceservice auth_token is required for auth_mode=bearer")
+		}
+	case "hmac":
+		if cfg.PriceService.HMACSecret == "" {
+			return fmt.Errorf("priceservice hmac_secret is required for auth_mode=hmac")
+		}
+	default:
+		return fmt.Errorf("priceservice auth_mode must be none, bearer or hmac, got %q", cfg.PriceService.AuthMode)
+	}
+	if cfg.PriceService.EjectAfterFailures == 0 {
+		cfg.PriceService.EjectAfterFailures = 3
+	}
+	if cfg.PriceService.EjectDurationMs == 0 {
+		cfg.PriceService.EjectDurationMs = 30000
+	}
+	if cfg.PriceService.EjectAfterFailures < 0 || cfg.PriceService.EjectDurationMs < 0 || cfg.PriceService.HedgeDelayMs < 0 {
+		return fmt.Errorf("priceservice eject_after_failures, eject_duration_ms and hedge_delay_ms must be positive")
+	}
+	if cfg.PriceService.TimeoutMs == 0 {
+		cfg.PriceService.TimeoutMs = 10000
+	}
+	if cfg.PriceService.EnrichBudgetMs == 0 {
+		cfg.PriceService.EnrichBudgetMs = 300
+	}
+	if cfg.PriceService.BackgroundTimeoutMs == 0 {
+		cfg.PriceService.BackgroundTimeoutMs = 10000
+	}
+	if cfg.PriceService.MaxIdleConns == 0 {
+		cfg.PriceService.MaxIdleConns = 32
+	}
+	if cfg.PriceService.MaxConnsPerHost == 0 {
+		cfg.PriceService.MaxConnsPerHost = 64
+	}
+	if cfg.PriceService.IdleConnTimeoutMs == 0 {
+		cfg.PriceService.IdleConnTimeoutMs = 90000
+	}
+	if cfg.PriceService.BatchConcurrency == 0 {
+		cfg.PriceService.BatchConcurrency = 4
+	}
+	if cfg.PriceService.ChunkSize == 0 {
+		cfg.PriceService.ChunkSize = 50
+	}
+	if cfg.PriceService.ChunkConcurrency == 0 {
+		cfg.PriceService.ChunkConcurrency = 4
+	}
+	if cfg.PriceService.ChunkSize < 0 || cfg.PriceService.ChunkConcurrency < 0 {
+		return fmt.Errorf("priceservice chunk_size and chunk_concurrency must be positive")
+	}
+	if cfg.PriceService.TimeoutMs < 0 || cfg.PriceService.EnrichBudgetMs < -1 {
+		return fmt.Errorf("priceservice timeout_ms must be positive and enrich_budget_ms must be positive or -1")
+	}
+	if cfg.PriceService.BackgroundTimeoutMs < 0 {
+		return fmt.Errorf("priceservice background_timeout_ms must be positive")
+	}
+	if cfg.PriceService.MaxIdleConns < 0 || cfg.PriceService.MaxConnsPerHost < 0 || cfg.PriceService.IdleConnTimeoutMs < 0 || cfg.PriceService.BatchConcurrency < 0 {
+		return fmt.Errorf("priceservice connection pool settings must be positive")
+	}
+	if cfg.PriceService.MaxAttempts == 0 {
+		cfg.PriceService.MaxAttempts = 3
+	}
+	if cfg.PriceService.RetryBaseDelayMs == 0 {
+		cfg.PriceService.RetryBaseDelayMs = 100
+	}
+	if cfg.PriceService.RetryMaxDelayMs == 0 {
+		cfg.PriceService.RetryMaxDelayMs = 2000
+	}
+	if cfg.PriceService.MaxAttempts < 1 {
+		return fmt.Errorf("priceservice max_attempts must be at least 1")
+	}
+	if cfg.PriceService.RetryBaseDelayMs < 0 || cfg.PriceService.RetryMaxDelayMs < cfg.PriceService.RetryBaseDelayMs {
+		return fmt.Errorf("priceservice retry delays must satisfy 0 <= retry_base_delay_ms <= retry_max_delay_ms")
+	}
+	if cfg.PriceService.BreakerFailureThreshold == 0 {
+		cfg.PriceService.BreakerFailureThreshold = 5
+	}
+	if cfg.PriceService.BreakerCoolDownMs == 0 {
+		cfg.PriceService.BreakerCoolDownMs = 10000
+	}
+	if cfg.PriceService.BreakerHalfOpenMaxCalls == 0 {
+		cfg.PriceService.BreakerHalfOpenMaxCalls = 1
+	}
+	if cfg.PriceService.BreakerFailureThreshold < 1 || cfg.PriceService.BreakerCoolDownMs < 1 || cfg.PriceService.BreakerHalfOpenMaxCalls < 1 {
+		return fmt.Errorf("priceservice breaker settings must be positive")
+	}
+	if cfg.PriceService.CacheTTLMs == 0 {
+		cfg.PriceService.CacheTTLMs = 30000
+	}
+	if cfg.PriceService.CacheMaxEntries == 0 {
+		cfg.PriceService.CacheMaxEntries = 10000
+	}
+	if cfg.PriceService.CacheTTLMs < 0 || cfg.PriceService.CacheMaxEntries < 0 {
+		return fmt.Errorf("priceservice cache settings must be positive")
+	}
+	if cfg.PriceService.SyncPollIntervalMs == 0 {
+		cfg.PriceService.SyncPollIntervalMs = 1000
+	}
+	if cfg.PriceService.SyncBatchSize == 0 {
+		cfg.PriceService.SyncBatchSize = 50
+	}
+	if cfg.PriceService.SyncRetryBaseDelayMs == 0 {
+		cfg.PriceService.SyncRetryBaseDelayMs = 1000
+	}
+	if cfg.PriceService.SyncRetryMaxDelayMs == 0 {
+		cfg.PriceService.SyncRetryMaxDelayMs = 300000
+	}
+	if cfg.PriceService.SyncPollIntervalMs < 0 || cfg.PriceService.SyncBatchSize < 0 {
+		return fmt.Errorf("priceservice sync settings must be positive")
+	}
+	if cfg.PriceService.SyncRetryBaseDelayMs < 0 || cfg.PriceService.SyncRetryMaxDelayMs < cfg.PriceService.SyncRetryBaseDelayMs {
+		return fmt.Errorf("priceservice sync retry delays must satisfy 0 <= sync_retry_base_delay_ms <= sync_retry_max_delay_ms")
+	}
+
+	// Slots validation and defaults
+	if cfg.Slots.StepMinutes == 0 {
+		cfg.Slots.StepMinutes = 15
+	}
+	if cfg.Slots.StepMinutes < 0 || cfg.Slots.StepMinutes > 24*60 {
+		return fmt.Errorf("slots step_minutes must be between 1 and 1440")
+	}
+
+	// Auth validation and defaults
+	if cfg.Auth.Mode == "" {
+		cfg.Auth.Mode = "header"
+	}
+	switch cfg.Auth.Mode {
+	case "header":
+	case "jwt":
+		if cfg.Auth.JWTSecret == "" && cfg.Auth.JWKSFile == "" {
+			return fmt.Errorf("auth mode jwt requires jwt_secret (AUTH_JWT_SECRET) or jwks_file")
+		}
+	default:
+		return fmt.Errorf("auth mode must be header or jwt, got %q", cfg.Auth.Mode)
+	}
+	if cfg.Auth.JWKSCheckIntervalMs == 0 {
+		cfg.Auth.JWKSCheckIntervalMs = 5000
+	}
+	if cfg.Auth.LeewayMs == 0 {
+		cfg.Auth.LeewayMs = 30000
+	}
+	if cfg.Auth.UserIDClaim == "" {
+		cfg.Auth.UserIDClaim = "sub"
+	}
+	if cfg.Auth.RoleClaim == "" {
+		cfg.Auth.RoleClaim = "role"
+	}
+	if cfg.Auth.JWKSCheckIntervalMs < 0 || cfg.Auth.LeewayMs < 0 {
+		return fmt.Errorf("auth jwks_check_interval_ms and leeway_ms must be positive")
 	}
 
 	return nil
@@ -10,6 +10,8 @@ type Address struct {
 	Street      string
 	Building    string
 	Coordinates Coordinates
+	Capacity    int             // Количество услуг, оказываемых параллельно
+	BayCounts   map[BayType]int // Количество постов по типам
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -26,6 +28,7 @@ type AddressInput struct {
 	Street      string
 	Building    string
 	Coordinates Coordinates
+	Capacity    int
 }
 
 // AddressUpdateInput входные данные для обновления адреса
@@ -35,4 +38,5 @@ type AddressUpdateInput struct {
 	Street      string
 	Building    string
 	Coordinates Coordinates
+	Capacity    int
 }
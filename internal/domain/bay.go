@@ -0,0 +1,48 @@
+package domain
+
+import "time"
+
+// BayType тип поста
+type BayType string
+
+const (
+	BayTypeSelfService BayType = "self_service"
+	BayTypeAutomatic   BayType = "automatic"
+	BayTypeManual      BayType = "manual"
+	BayTypeDetailing   BayType = "detailing"
+)
+
+// IsValid проверяет, что тип поста известен
+func (t BayType) IsValid() bool {
+	switch t {
+	case BayTypeSelfService, BayTypeAutomatic, BayTypeManual, BayTypeDetailing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Bay представляет пост (бокс) на адресе компании
+type Bay struct {
+	ID         int64
+	AddressID  int64
+	Name       string
+	Type       BayType
+	ServiceIDs []int64 // Услуги, которые можно оказать на посту
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// CreateBayInput входные данные для создания поста
+type CreateBayInput struct {
+	Name       string
+	Type       BayType
+	ServiceIDs []int64
+}
+
+// UpdateBayInput входные данные для обновления поста
+type UpdateBayInput struct {
+	Name       *string
+	Type       *BayType
+	ServiceIDs []int64 // nil - не менять, пустой список - отвязать все услуги
+}
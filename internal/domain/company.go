@@ -8,6 +8,7 @@ type Company struct {
 	Name         string
 	Logo         *string
 	Description  *string
+	Translations Translations
 	Tags         []string
 	Addresses    []Address
 	WorkingHours WorkingHours
@@ -17,20 +18,58 @@ type Company struct {
 }
 
 // CompanyPublic представляет публичную информацию о компании
+// Без менеджеров, переводов, вместимости адресов и служебных дат
 type CompanyPublic struct {
 	ID           int64
 	Name         string
 	Logo         *string
 	Description  *string
 	Tags         []string
+	Addresses    []AddressPublic
 	WorkingHours WorkingHours
 }
 
+// AddressPublic представляет публичную информацию об адресе компании
+type AddressPublic struct {
+	ID          int64
+	City        string
+	Street      string
+	Building    string
+	Coordinates Coordinates
+	BayCounts   map[BayType]int
+}
+
+// Public возвращает публичную информацию о компании
+func (c *Company) Public() *CompanyPublic {
+	addresses := make([]AddressPublic, len(c.Addresses))
+	for i, addr := range c.Addresses {
+		addresses[i] = AddressPublic{
+			ID:          addr.ID,
+			City:        addr.City,
+			Street:      addr.Street,
+			Building:    addr.Building,
+			Coordinates: addr.Coordinates,
+			BayCounts:   addr.BayCounts,
+		}
+	}
+
+	return &CompanyPublic{
+		ID:           c.ID,
+		Name:         c.Name,
+		Logo:         c.Logo,
+		Description:  c.Description,
+		Tags:         c.Tags,
+		Addresses:    addresses,
+		WorkingHours: c.WorkingHours,
+	}
+}
+
 // CreateCompanyInput входные данные для создания компании
 type CreateCompanyInput struct {
 	Name         string
 	Logo         *string
 	Description  *string
+	Translations Translations
 	Tags         []string
 	Addresses    []AddressInput
 	WorkingHours WorkingHours
@@ -42,6 +81,7 @@ type UpdateCompanyInput struct {
 	Name         *string
 	Logo         *string
 	Description  *string
+	Translations Translations // nil - не менять, пустой набор - удалить переводы
 	Tags         []string
 	Addresses    []AddressUpdateInput
 	WorkingHours *WorkingHours
@@ -50,8 +90,9 @@ type UpdateCompanyInput struct {
 
 // CompanyFilter фильтры для поиска компаний
 type CompanyFilter struct {
-	Tags  []string
-	City  *string
-	Page  *int // Опционально: если nil, пагинация не применяется
-	Limit *int // Опционально: если nil, пагинация не применяется
+	Tags    []string
+	City    *string
+	BayType *BayType // Только компании, у которых есть пост такого типа
+	Page    *int     // Опционально: если nil, пагинация не применяется
+	Limit   *int     // Опционально: если nil, пагинация не применяется
 }
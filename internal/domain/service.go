@@ -8,12 +8,36 @@ type Service struct {
 	CompanyID       int64
 	Name            string
 	Description     *string
+	Translations    Translations
 	AverageDuration *int
 	AddressIDs      []int64
+	IsActive        bool
+	TemplateID      *int64   // Шаблон, из которого создана услуга
+	VehicleClasses  []string // Классы автомобилей; пустой список - любой класс
+	BasePrice       *float64 // Запасная цена на случай недоступности PriceService
+	BaseCurrency    *string  // Валюта базовой цены (ISO 4217)
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
 
+// DefaultCurrency валюта базовой цены, если менеджер её не указал
+const DefaultCurrency = "RUB"
+
+// ServiceBasePrice базовая цена услуги, заданная менеджером компании
+type ServiceBasePrice struct {
+	ServiceID int64
+	Price     float64
+	Currency  string
+}
+
+// ServiceRevision снимок услуги после создания или изменения
+type ServiceRevision struct {
+	Revision  int
+	Service   Service   // Состояние услуги в этой ревизии
+	ValidFrom time.Time // Момент, с которого действовало состояние
+	Deleted   bool      // Надгробие: с ValidFrom услуга удалена, Service - последнее состояние
+}
+
 // ServicePublic представляет публичную информацию об услуге
 type ServicePublic struct {
 	ID              int64
@@ -28,14 +52,62 @@ type ServicePublic struct {
 type CreateServiceInput struct {
 	Name            string
 	Description     *string
+	Translations    Translations
 	AverageDuration *int
 	AddressIDs      []int64
+	IsActive        bool
+	TemplateID      *int64
+	VehicleClasses  []string
+	BasePrice       *float64
+	BaseCurrency    *string
 }
 
 // UpdateServiceInput входные данные для обновления услуги
 type UpdateServiceInput struct {
 	Name            *string
 	Description     *string
+	Translations    Translations // nil - не менять, пустой набор - удалить переводы
 	AverageDuration *int
 	AddressIDs      []int64
+	IsActive        *bool
+	VehicleClasses  []string // nil - не менять, пустой список - любой класс
+	BasePrice       *float64
+	BaseCurrency    *string
+	ClearBasePrice  bool // true - сбросить базовую цену и валюту в NULL
+}
+
+// ServiceFilter фильтры для списка услуг компании
+type ServiceFilter struct {
+	OnlyActive   bool    // Если true, скрытые услуги не возвращаются
+	VehicleClass *string // Только услуги, применимые к классу автомобиля
+}
+
+// ServiceBatchOperationType тип операции в пакетном изменении услуг
+type ServiceBatchOperationType string
+
+const (
+	ServiceBatchCreate ServiceBatchOperationType = "create"
+	ServiceBatchUpdate ServiceBatchOperationType = "update"
+	ServiceBatchDelete ServiceBatchOperationType = "delete"
+)
+
+// ServiceBatchOperation одна операция пакетного изменения услуг
+type ServiceBatchOperation struct {
+	Type      ServiceBatchOperationType
+	ServiceID *int64              // Для update и delete
+	Create    *CreateServiceInput // Для create
+	Update    *UpdateServiceInput // Для update
+}
+
+// ServiceBatchResult результат одной операции пакета
+type ServiceBatchResult struct {
+	Executed bool     // false - операция не выполнялась (атомарный пакет прерван раньше)
+	Service  *Service // Созданная или обновлённая услуга
+	Err      error
+}
+
+// ServiceBatchOutcome итог выполнения пакета
+type ServiceBatchOutcome struct {
+	Results   []ServiceBatchResult
+	Committed bool // false - транзакция откачена целиком
 }
@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// ServiceEventType тип события жизненного цикла услуги
+type ServiceEventType string
+
+const (
+	ServiceEventCreated ServiceEventType = "created"
+	ServiceEventUpdated ServiceEventType = "updated"
+	ServiceEventDeleted ServiceEventType = "deleted"
+)
+
+// ServiceEvent событие жизненного цикла услуги, ожидающее доставки в PriceService
+type ServiceEvent struct {
+	ID        int64 // Уникален и используется получателем для идемпотентности
+	Type      ServiceEventType
+	ServiceID int64
+	CompanyID int64
+	Attempts  int // Попыток доставки, включая текущую
+	CreatedAt time.Time
+}
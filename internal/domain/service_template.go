@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// ServiceTemplate представляет шаблон услуги из глобального каталога
+type ServiceTemplate struct {
+	ID              int64
+	Name            string
+	Description     *string
+	DefaultDuration *int
+	Category        string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// CreateServiceTemplateInput входные данные для создания шаблона
+type CreateServiceTemplateInput struct {
+	Name            string
+	Description     *string
+	DefaultDuration *int
+	Category        string
+}
+
+// UpdateServiceTemplateInput входные данные для обновления шаблона
+type UpdateServiceTemplateInput struct {
+	Name            *string
+	Description     *string
+	DefaultDuration *int
+	Category        *string
+}
+
+// ServiceTemplateFilter фильтры для списка шаблонов
+type ServiceTemplateFilter struct {
+	Category *string
+}
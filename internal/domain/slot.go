@@ -0,0 +1,7 @@
+package domain
+
+// BusyInterval занятый интервал на адресе: одна запись занимает один пост
+type BusyInterval struct {
+	Start TimeString // Формат "HH:MM"
+	End   TimeString // Формат "HH:MM"
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Locale код языка контента (ISO 639-1)
+type Locale string
+
+const (
+	LocaleRu Locale = "ru"
+	LocaleEn Locale = "en"
+	LocaleKk Locale = "kk"
+)
+
+// SupportedLocales языки, на которые можно переводить контент
+var SupportedLocales = []Locale{LocaleRu, LocaleEn, LocaleKk}
+
+// IsValid проверяет, что язык поддерживается
+func (l Locale) IsValid() bool {
+	for _, supported := range SupportedLocales {
+		if l == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// Translation перевод названия и описания на один язык
+// Незаданные поля берутся из базового текста
+type Translation struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Translations переводы контента по языкам, хранятся в JSONB рядом с базовым текстом
+type Translations map[Locale]Translation
+
+// Validate проверяет коды языков и непустоту названий
+func (t Translations) Validate() error {
+	for locale, tr := range t {
+		if !locale.IsValid() {
+			return fmt.Errorf("unsupported locale %q", locale)
+		}
+		if tr.Name != nil && strings.TrimSpace(*tr.Name) == "" {
+			return fmt.Errorf("translation name for locale %q must not be empty", locale)
+		}
+	}
+	return nil
+}
+
+// Localize возвращает название и описание на языке locale
+// При отсутствии перевода используется базовый текст
+func (t Translations) Localize(locale Locale, name string, description *string) (string, *string) {
+	tr, ok := t[locale]
+	if !ok {
+		return name, description
+	}
+	if tr.Name != nil {
+		name = *tr.Name
+	}
+	if tr.Description != nil {
+		description = tr.Description
+	}
+	return name, description
+}
+
+// Scan implements sql.Scanner interface
+func (t *Translations) Scan(value interface{}) error {
+	if value == nil {
+		*t = nil
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("cannot scan type %T into Translations", value)
+	}
+
+	translations := make(Translations)
+	if err := json.Unmarshal(data, &translations); err != nil {
+		return fmt.Errorf("cannot unmarshal translations: %w", err)
+	}
+	*t = translations
+	return nil
+}
+
+// Value implements driver.Valuer interface
+func (t Translations) Value() (driver.Value, error) {
+	if t == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(t)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
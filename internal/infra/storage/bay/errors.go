@@ -0,0 +1,44 @@
+package bay
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrBayNotFound возвращается, когда пост не найден на адресе компании
+	ErrBayNotFound = errors.New("repository: bay not found")
+
+	// ErrAddressNotFound возвращается, когда адрес не найден у компании
+	ErrAddressNotFound = errors.New("repository: address not found")
+
+	// ErrBuildQuery возвращается при ошибке построения SQL запроса
+	ErrBuildQuery = errors.New("repository: failed to build SQL query")
+
+	// ErrExecQuery возвращается при ошибке выполнения SQL запроса
+	ErrExecQuery = errors.New("repository: failed to execute SQL query")
+
+	// ErrScanRow возвращается при ошибке сканирования строки из БД
+	ErrScanRow = errors.New("repository: failed to scan row")
+
+	// ErrTransaction возвращается при ошибке работы с транзакцией
+	ErrTransaction = errors.New("repository: transaction error")
+)
+
+// ErrServiceNotAtAddress возвращается, когда услуга поста не оказывается на его адресе
+var ErrServiceNotAtAddress = errors.New("repository: service is not offered at address")
+
+// BayServicesError содержит ID услуг, которые не оказываются на адресе поста
+type BayServicesError struct {
+	AddressID  int64
+	ServiceIDs []int64
+}
+
+func (e *BayServicesError) Error() string {
+	return fmt.Sprintf("%v: address_id=%d, service_ids=%v", ErrServiceNotAtAddress, e.AddressID, e.ServiceIDs)
+}
+
+// Unwrap позволяет проверять ошибку через errors.Is(err, ErrServiceNotAtAddress)
+func (e *BayServicesError) Unwrap() error {
+	return ErrServiceNotAtAddress
+}
@@ -0,0 +1,470 @@
+package bay
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/pkg/dbmetrics"
+	"github.com/m04kA/SMK-SellerService/pkg/psqlbuilder"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Repository репозиторий для работы с постами адресов
+type Repository struct {
+	db DBExecutor
+}
+
+// NewRepository создает новый экземпляр репозитория постов
+func NewRepository(db DBExecutor) *Repository {
+	return &Repository{db: db}
+}
+
+// Create создает пост на адресе компании
+func (r *Repository) Create(ctx context.Context, companyID int64, addressID int64, input domain.CreateBayInput) (*domain.Bay, error) {
+	tx, err := r.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Create - begin transaction: %v", ErrTransaction, err)
+	}
+
+	if err := r.checkAddress(ctx, tx, companyID, addressID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	query, args, err := psqlbuilder.Insert("bays").
+		Columns("address_id", "name", "type").
+		Values(addressID, input.Name, string(input.Type)).
+		Suffix("RETURNING id, created_at, updated_at").
+		ToSql()
+
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: Create - build insert query: %v", ErrBuildQuery, err)
+	}
+
+	var bayID int64
+	var createdAt, updatedAt sql.NullTime
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&bayID, &createdAt, &updatedAt)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("%w: Create - insert bay: %v", ErrExecQuery, err)
+	}
+
+	serviceIDs, err := r.replaceBayServices(ctx, tx, companyID, addressID, bayID, input.ServiceIDs)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("Create - %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: Create - commit transaction: %v", ErrTransaction, err)
+	}
+
+	return &domain.Bay{
+		ID:         bayID,
+		AddressID:  addressID,
+		Name:       input.Name,
+		Type:       input.Type,
+		ServiceIDs: serviceIDs,
+		CreatedAt:  createdAt.Time,
+		UpdatedAt:  updatedAt.Time,
+	}, nil
+}
+
+// GetByID получает пост адреса компании по ID
+func (r *Repository) GetByID(ctx context.Context, companyID int64, addressID int64, bayID int64) (*domain.Bay, error) {
+	query, args, err := psqlbuilder.Select("b.id", "b.address_id", "b.name", "b.type", "b.created_at", "b.updated_at").
+		From("bays b").
+		Join("addresses a ON a.id = b.address_id").
+		Where(squirrel.Eq{"b.id": bayID, "b.address_id": addressID, "a.company_id": companyID}).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetByID - build select query: %v", ErrBuildQuery, err)
+	}
+
+	var bay domain.Bay
+	var createdAt, updatedAt sql.NullTime
+
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(
+		&bay.ID,
+		&bay.AddressID,
+		&bay.Name,
+		&bay.Type,
+		&createdAt,
+		&updatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, ErrBayNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetByID - scan bay: %v", ErrScanRow, err)
+	}
+
+	bay.CreatedAt = createdAt.Time
+	bay.UpdatedAt = updatedAt.Time
+
+	serviceIDs, err := r.getBayServiceIDs(ctx, r.db, bay.ID)
+	if err != nil {
+		return nil, fmt.Errorf("GetByID - failed to get service ids: %w", err)
+	}
+	bay.ServiceIDs = serviceIDs
+
+	return &bay, nil
+}
+
+// ListByAddress получает посты адреса компании
+func (r *Repository) ListByAddress(ctx context.Context, companyID int64, addressID int64) ([]domain.Bay, error) {
+	if err := r.checkAddress(ctx, r.db, companyID, addressID); err != nil {
+		return nil, err
+	}
+
+	query, args, err := psqlbuilder.Select("id", "address_id", "name", "type", "created_at", "updated_at").
+		From("bays").
+		Where(squirrel.Eq{"address_id": addressID}).
+		OrderBy("id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListByAddress - build select query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListByAddress - execute select: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	bays := make([]domain.Bay, 0)
+	for rows.Next() {
+		var bay domain.Bay
+		var createdAt, updatedAt sql.NullTime
+
+		err := rows.Scan(
+			&bay.ID,
+			&bay.AddressID,
+			&bay.Name,
+			&bay.Type,
+			&createdAt,
+			&updatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: ListByAddress - scan bay: %v", ErrScanRow, err)
+		}
+
+		bay.CreatedAt = createdAt.Time
+		bay.UpdatedAt = updatedAt.Time
+		bays = append(bays, bay)
+	}
+	rows.Close()
+
+	// Загружаем услуги для каждого поста
+	for i := range bays {
+		serviceIDs, err := r.getBayServiceIDs(ctx, r.db, bays[i].ID)
+		if err != nil {
+			return nil, fmt.Errorf("ListByAddress - failed to get service ids: %w", err)
+		}
+		bays[i].ServiceIDs = serviceIDs
+	}
+
+	return bays, nil
+}
+
+// Update обновляет пост
+func (r *Repository) Update(ctx context.Context, companyID int64, addressID int64, bayID int64, input domain.UpdateBayInput) (*domain.Bay, error) {
+	tx, err := r.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Update - begin transaction: %v", ErrTransaction, err)
+	}
+
+	if err := r.checkBay(ctx, tx, companyID, addressID, bayID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if input.Name != nil || input.Type != nil {
+		updateBuilder := psqlbuilder.Update("bays").Where(squirrel.Eq{"id": bayID})
+		if input.Name != nil {
+			updateBuilder = updateBuilder.Set("name", *input.Name)
+		}
+		if input.Type != nil {
+			updateBuilder = updateBuilder.Set("type", string(*input.Type))
+		}
+
+		query, args, err := updateBuilder.ToSql()
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("%w: Update - build update query: %v", ErrBuildQuery, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("%w: Update - execute update: %v", ErrExecQuery, err)
+		}
+	}
+
+	if input.ServiceIDs != nil {
+		if _, err := r.replaceBayServices(ctx, tx, companyID, addressID, bayID, input.ServiceIDs); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("Update - %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: Update - commit transaction: %v", ErrTransaction, err)
+	}
+
+	// Возвращаем обновленный пост
+	return r.GetByID(ctx, companyID, addressID, bayID)
+}
+
+// Delete удаляет пост
+func (r *Repository) Delete(ctx context.Context, companyID int64, addressID int64, bayID int64) error {
+	query, args, err := psqlbuilder.Delete("bays").
+		Where(squirrel.Eq{"id": bayID, "address_id": addressID}).
+		Where("address_id IN (SELECT id FROM addresses WHERE company_id = ?)", companyID).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: Delete - build delete query: %v", ErrBuildQuery, err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: Delete - execute delete: %v", ErrExecQuery, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: Delete - get rows affected: %v", ErrExecQuery, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrBayNotFound
+	}
+
+	return nil
+}
+
+// CountForService возвращает общее число постов адреса и число постов, на которых оказывается услуга
+func (r *Repository) CountForService(ctx context.Context, addressID int64, serviceID int64) (total int, capable int, err error) {
+	query, args, err := psqlbuilder.Select("COUNT(*)", "COUNT(bs.service_id)").
+		From("bays b").
+		LeftJoin("bay_services bs ON bs.bay_id = b.id AND bs.service_id = ?", serviceID).
+		Where(squirrel.Eq{"b.address_id": addressID}).
+		ToSql()
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: CountForService - build select query: %v", ErrBuildQuery, err)
+	}
+
+	if err := r.db.QueryRowContext(ctx, query, args...).Scan(&total, &capable); err != nil {
+		return 0, 0, fmt.Errorf("%w: CountForService - scan counts: %v", ErrScanRow, err)
+	}
+
+	return total, capable, nil
+}
+
+// Helper methods
+
+// checkAddress проверяет, что адрес принадлежит компании
+func (r *Repository) checkAddress(ctx context.Context, db DBExecutor, companyID int64, addressID int64) error {
+	query, args, err := psqlbuilder.Select("id").
+		From("addresses").
+		Where(squirrel.Eq{"id": addressID, "company_id": companyID}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: checkAddress - build select query: %v", ErrBuildQuery, err)
+	}
+
+	var id int64
+	err = db.QueryRowContext(ctx, query, args...).Scan(&id)
+	if err == sql.ErrNoRows {
+		return ErrAddressNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%w: checkAddress - scan address: %v", ErrScanRow, err)
+	}
+
+	return nil
+}
+
+// checkBay проверяет, что пост существует на адресе компании, и блокирует его до конца транзакции
+func (r *Repository) checkBay(ctx context.Context, tx TxExecutor, companyID int64, addressID int64, bayID int64) error {
+	query, args, err := psqlbuilder.Select("b.id").
+		From("bays b").
+		Join("addresses a ON a.id = b.address_id").
+		Where(squirrel.Eq{"b.id": bayID, "b.address_id": addressID, "a.company_id": companyID}).
+		Suffix("FOR UPDATE OF b").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: checkBay - build select query: %v", ErrBuildQuery, err)
+	}
+
+	var id int64
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&id)
+	if err == sql.ErrNoRows {
+		return ErrBayNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("%w: checkBay - scan bay: %v", ErrScanRow, err)
+	}
+
+	return nil
+}
+
+// replaceBayServices заменяет набор услуг поста; все услуги должны оказываться на адресе поста
+func (r *Repository) replaceBayServices(ctx context.Context, tx TxExecutor, companyID int64, addressID int64, bayID int64, serviceIDs []int64) ([]int64, error) {
+	serviceIDs = uniqueIDs(serviceIDs)
+	if err := r.validateBayServices(ctx, tx, companyID, addressID, serviceIDs); err != nil {
+		return nil, err
+	}
+
+	deleteQuery, deleteArgs, err := psqlbuilder.Delete("bay_services").
+		Where(squirrel.Eq{"bay_id": bayID}).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: replaceBayServices - build delete query: %v", ErrBuildQuery, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteQuery, deleteArgs...); err != nil {
+		return nil, fmt.Errorf("%w: replaceBayServices - delete old services: %v", ErrExecQuery, err)
+	}
+
+	if len(serviceIDs) == 0 {
+		return serviceIDs, nil
+	}
+
+	insertBuilder := psqlbuilder.Insert("bay_services").Columns("bay_id", "service_id")
+	for _, serviceID := range serviceIDs {
+		insertBuilder = insertBuilder.Values(bayID, serviceID)
+	}
+
+	insertQuery, insertArgs, err := insertBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: replaceBayServices - build insert query: %v", ErrBuildQuery, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, insertQuery, insertArgs...); err != nil {
+		return nil, fmt.Errorf("%w: replaceBayServices - insert services: %v", ErrExecQuery, err)
+	}
+
+	return serviceIDs, nil
+}
+
+// validateBayServices проверяет, что услуги принадлежат компании и оказываются на адресе поста
+func (r *Repository) validateBayServices(ctx context.Context, tx TxExecutor, companyID int64, addressID int64, serviceIDs []int64) error {
+	if len(serviceIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := psqlbuilder.Select("s.id").
+		From("services s").
+		Join("service_addresses sa ON sa.service_id = s.id").
+		Where(squirrel.Eq{"s.company_id": companyID, "sa.address_id": addressID, "s.id": serviceIDs}).
+		Suffix("FOR SHARE OF s").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: validateBayServices - build select query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: validateBayServices - select services: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	offered := make(map[int64]struct{}, len(serviceIDs))
+	for rows.Next() {
+		var serviceID int64
+		if err := rows.Scan(&serviceID); err != nil {
+			return fmt.Errorf("%w: validateBayServices - scan service id: %v", ErrScanRow, err)
+		}
+		offered[serviceID] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: validateBayServices - iterate rows: %v", ErrScanRow, err)
+	}
+
+	missing := make([]int64, 0)
+	for _, serviceID := range serviceIDs {
+		if _, ok := offered[serviceID]; !ok {
+			missing = append(missing, serviceID)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &BayServicesError{AddressID: addressID, ServiceIDs: missing}
+	}
+
+	return nil
+}
+
+func (r *Repository) getBayServiceIDs(ctx context.Context, db DBExecutor, bayID int64) ([]int64, error) {
+	query, args, err := psqlbuilder.Select("service_id").
+		From("bay_services").
+		Where(squirrel.Eq{"bay_id": bayID}).
+		OrderBy("service_id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to build select bay services query: %w", err)
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	serviceIDs := make([]int64, 0)
+	for rows.Next() {
+		var serviceID int64
+		if err := rows.Scan(&serviceID); err != nil {
+			return nil, err
+		}
+		serviceIDs = append(serviceIDs, serviceID)
+	}
+
+	return serviceIDs, nil
+}
+
+func (r *Repository) beginTx(ctx context.Context) (TxExecutor, error) {
+	// Пытаемся привести к TxBeginner интерфейсу (dbmetrics.DB реализует этот интерфейс)
+	if txBeginner, ok := r.db.(TxBeginner); ok {
+		return txBeginner.BeginTx(ctx, nil)
+	}
+
+	// Fallback для обычного *sql.DB
+	if db, ok := r.db.(*sql.DB); ok {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: beginTx: %v", ErrTransaction, err)
+		}
+		return &dbmetrics.SqlTxWrapper{Tx: tx}, nil
+	}
+
+	return nil, fmt.Errorf("%w: db type not supported", ErrTransaction)
+}
+
+// uniqueIDs убирает дубликаты с сохранением порядка
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -6,6 +6,9 @@ var (
 	// ErrCompanyNotFound возвращается, когда компания не найдена в БД
 	ErrCompanyNotFound = errors.New("repository: company not found")
 
+	// ErrAddressNotFound возвращается, когда обновляемый адрес не найден у компании
+	ErrAddressNotFound = errors.New("repository: address not found")
+
 	// ErrBuildQuery возвращается при ошибке построения SQL запроса
 	ErrBuildQuery = errors.New("repository: failed to build SQL query")
 
@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/internal/infra/storage/outbox"
+	serviceRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/service"
 	"github.com/m04kA/SMK-SellerService/pkg/dbmetrics"
 	"github.com/m04kA/SMK-SellerService/pkg/psqlbuilder"
 
@@ -32,8 +34,8 @@ func (r *Repository) Create(ctx context.Context, input domain.CreateCompanyInput
 
 	// Создаем компанию
 	query, args, err := psqlbuilder.Insert("companies").
-		Columns("name", "logo", "description", "tags", "manager_ids").
-		Values(input.Name, input.Logo, input.Description, pq.Array(input.Tags), pq.Array(input.ManagerIDs)).
+		Columns("name", "logo", "description", "translations", "tags", "manager_ids").
+		Values(input.Name, input.Logo, input.Description, input.Translations, pq.Array(input.Tags), pq.Array(input.ManagerIDs)).
 		Suffix("RETURNING id, created_at, updated_at").
 		ToSql()
 
@@ -77,6 +79,7 @@ func (r *Repository) Create(ctx context.Context, input domain.CreateCompanyInput
 		Name:         input.Name,
 		Logo:         input.Logo,
 		Description:  input.Description,
+		Translations: input.Translations,
 		Tags:         input.Tags,
 		Addresses:    addresses,
 		WorkingHours: input.WorkingHours,
@@ -88,7 +91,7 @@ func (r *Repository) Create(ctx context.Context, input domain.CreateCompanyInput
 
 // GetByID получает компанию по ID
 func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Company, error) {
-	query, args, err := psqlbuilder.Select("id", "name", "logo", "description", "tags", "manager_ids", "created_at", "updated_at").
+	query, args, err := psqlbuilder.Select("id", "name", "logo", "description", "translations", "tags", "manager_ids", "created_at", "updated_at").
 		From("companies").
 		Where(squirrel.Eq{"id": id}).
 		ToSql()
@@ -107,6 +110,7 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Company, er
 		&company.Name,
 		&company.Logo,
 		&company.Description,
+		&company.Translations,
 		&tags,
 		&managerIDs,
 		&createdAt,
@@ -146,7 +150,7 @@ func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.Company, er
 // List получает список компаний с фильтрацией
 func (r *Repository) List(ctx context.Context, filter domain.CompanyFilter) ([]domain.Company, *domain.PaginationResult, error) {
 	// Базовый запрос
-	selectBuilder := psqlbuilder.Select("id", "name", "logo", "description", "tags", "manager_ids", "created_at", "updated_at").
+	selectBuilder := psqlbuilder.Select("id", "name", "logo", "description", "translations", "tags", "manager_ids", "created_at", "updated_at").
 		From("companies").
 		OrderBy("created_at DESC")
 
@@ -160,6 +164,11 @@ func (r *Repository) List(ctx context.Context, filter domain.CompanyFilter) ([]d
 		selectBuilder = selectBuilder.Where("id IN (SELECT company_id FROM addresses WHERE city = ?)", *filter.City)
 	}
 
+	if filter.BayType != nil {
+		// Подзапрос для фильтрации по типу постов на адресах компании
+		selectBuilder = selectBuilder.Where("id IN (SELECT a.company_id FROM addresses a JOIN bays b ON b.address_id = a.id WHERE b.type = ?)", string(*filter.BayType))
+	}
+
 	// Применяем пагинацию только если Page и Limit заданы
 	var pagination *domain.PaginationResult
 	if filter.Page != nil && filter.Limit != nil {
@@ -190,6 +199,7 @@ func (r *Repository) List(ctx context.Context, filter domain.CompanyFilter) ([]d
 			&company.Name,
 			&company.Logo,
 			&company.Description,
+			&company.Translations,
 			&tags,
 			&managerIDs,
 			&createdAt,
@@ -267,6 +277,9 @@ func (r *Repository) Update(ctx context.Context, id int64, input domain.UpdateCo
 	if input.Description != nil {
 		updateBuilder = updateBuilder.Set("description", *input.Description)
 	}
+	if input.Translations != nil {
+		updateBuilder = updateBuilder.Set("translations", input.Translations)
+	}
 	if len(input.Tags) > 0 {
 		updateBuilder = updateBuilder.Set("tags", pq.Array(input.Tags))
 	}
@@ -298,12 +311,22 @@ func (r *Repository) Update(ctx context.Context, id int64, input domain.UpdateCo
 	}
 
 	// Обновляем адреса, если переданы
+	// Адреса с ID обновляются на месте, сохраняя привязанные услуги, посты и занятость;
+	// адреса без ID создаются, адреса компании, отсутствующие в запросе, удаляются
 	if len(input.Addresses) > 0 {
-		// Удаляем старые адреса
-		deleteQuery, deleteArgs, err := psqlbuilder.Delete("addresses").
-			Where(squirrel.Eq{"company_id": id}).
-			ToSql()
+		keepIDs := make([]int64, 0, len(input.Addresses))
+		for _, addr := range input.Addresses {
+			if addr.ID != nil {
+				keepIDs = append(keepIDs, *addr.ID)
+			}
+		}
 
+		deleteBuilder := psqlbuilder.Delete("addresses").Where(squirrel.Eq{"company_id": id})
+		if len(keepIDs) > 0 {
+			deleteBuilder = deleteBuilder.Where(squirrel.NotEq{"id": keepIDs})
+		}
+
+		deleteQuery, deleteArgs, err := deleteBuilder.ToSql()
 		if err != nil {
 			tx.Rollback()
 			return nil, fmt.Errorf("%w: Update - build delete addresses query: %v", ErrBuildQuery, err)
@@ -315,13 +338,21 @@ func (r *Repository) Update(ctx context.Context, id int64, input domain.UpdateCo
 			return nil, fmt.Errorf("%w: Update - delete old addresses: %v", ErrExecQuery, err)
 		}
 
-		// Создаем новые адреса
 		for _, addr := range input.Addresses {
+			if addr.ID != nil {
+				if err := r.updateAddress(ctx, tx, id, addr); err != nil {
+					tx.Rollback()
+					return nil, fmt.Errorf("Update - failed to update address: %w", err)
+				}
+				continue
+			}
+
 			addressInput := domain.AddressInput{
 				City:        addr.City,
 				Street:      addr.Street,
 				Building:    addr.Building,
 				Coordinates: addr.Coordinates,
+				Capacity:    addr.Capacity,
 			}
 			_, err := r.createAddress(ctx, tx, id, addressInput)
 			if err != nil {
@@ -349,27 +380,50 @@ func (r *Repository) Update(ctx context.Context, id int64, input domain.UpdateCo
 }
 
 // Delete удаляет компанию
+// Услуги компании удаляются каскадно, поэтому ревизии-надгробия и события их удаления
+// для PriceService записываются в той же транзакции
 func (r *Repository) Delete(ctx context.Context, id int64) error {
+	tx, err := r.beginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: Delete - begin transaction: %v", ErrTransaction, err)
+	}
+
+	serviceIDs, err := r.lockServiceIDs(ctx, tx, id)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, serviceID := range serviceIDs {
+		// История услуги переживает удаление, запрос на момент после него не найдёт услугу
+		if err := serviceRepo.WriteTombstone(ctx, tx, serviceID); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("Delete - failed to write revision: %w", err)
+		}
+
+		// PriceService удалит цены услуги после коммита
+		if err := outbox.WriteEvent(ctx, tx, domain.ServiceEventDeleted, id, serviceID); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("Delete - failed to write event: %w", err)
+		}
+	}
+
 	query, args, err := psqlbuilder.Delete("companies").
 		Where(squirrel.Eq{"id": id}).
 		ToSql()
 
 	if err != nil {
+		tx.Rollback()
 		return fmt.Errorf("%w: Delete - build delete query: %v", ErrBuildQuery, err)
 	}
 
-	result, err := r.db.ExecContext(ctx, query, args...)
-	if err != nil {
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		tx.Rollback()
 		return fmt.Errorf("%w: Delete - execute delete: %v", ErrExecQuery, err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		return fmt.Errorf("%w: Delete - get rows affected: %v", ErrExecQuery, err)
-	}
-
-	if rowsAffected == 0 {
-		return ErrCompanyNotFound
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: Delete - commit transaction: %v", ErrTransaction, err)
 	}
 
 	return nil
@@ -425,10 +479,66 @@ func (r *Repository) beginTx(ctx context.Context) (TxExecutor, error) {
 	return nil, fmt.Errorf("%w: db type not supported", ErrTransaction)
 }
 
+// lockServiceIDs блокирует компанию и её услуги и возвращает ID услуг
+// Блокировка компании не даёт создать услугу, которая не попадёт в события удаления,
+// а блокировка услуг - записать ревизию параллельно с надгробием
+func (r *Repository) lockServiceIDs(ctx context.Context, tx TxExecutor, companyID int64) ([]int64, error) {
+	lockQuery, lockArgs, err := psqlbuilder.Select("id").
+		From("companies").
+		Where(squirrel.Eq{"id": companyID}).
+		Suffix("FOR UPDATE").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: lockServiceIDs - build lock query: %v", ErrBuildQuery, err)
+	}
+
+	var lockedID int64
+	err = tx.QueryRowContext(ctx, lockQuery, lockArgs...).Scan(&lockedID)
+	if err == sql.ErrNoRows {
+		return nil, ErrCompanyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: lockServiceIDs - lock company: %v", ErrExecQuery, err)
+	}
+
+	query, args, err := psqlbuilder.Select("id").
+		From("services").
+		Where(squirrel.Eq{"company_id": companyID}).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: lockServiceIDs - build select query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: lockServiceIDs - execute query: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	var serviceIDs []int64
+	for rows.Next() {
+		var serviceID int64
+		if err := rows.Scan(&serviceID); err != nil {
+			return nil, fmt.Errorf("%w: lockServiceIDs - scan service ID: %v", ErrScanRow, err)
+		}
+		serviceIDs = append(serviceIDs, serviceID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: lockServiceIDs - rows iteration: %v", ErrExecQuery, err)
+	}
+
+	return serviceIDs, nil
+}
+
 func (r *Repository) createAddress(ctx context.Context, tx TxExecutor, companyID int64, input domain.AddressInput) (*domain.Address, error) {
 	query, args, err := psqlbuilder.Insert("addresses").
-		Columns("company_id", "city", "street", "building", "latitude", "longitude").
-		Values(companyID, input.City, input.Street, input.Building, input.Coordinates.Latitude, input.Coordinates.Longitude).
+		Columns("company_id", "city", "street", "building", "latitude", "longitude", "capacity").
+		Values(companyID, input.City, input.Street, input.Building, input.Coordinates.Latitude, input.Coordinates.Longitude, input.Capacity).
 		Suffix("RETURNING id, created_at, updated_at").
 		ToSql()
 
@@ -449,12 +559,46 @@ func (r *Repository) createAddress(ctx context.Context, tx TxExecutor, companyID
 	address.Street = input.Street
 	address.Building = input.Building
 	address.Coordinates = input.Coordinates
+	address.Capacity = input.Capacity
 	address.CreatedAt = createdAt.Time
 	address.UpdatedAt = updatedAt.Time
 
 	return &address, nil
 }
 
+// updateAddress обновляет существующий адрес компании
+func (r *Repository) updateAddress(ctx context.Context, tx TxExecutor, companyID int64, input domain.AddressUpdateInput) error {
+	query, args, err := psqlbuilder.Update("addresses").
+		Set("city", input.City).
+		Set("street", input.Street).
+		Set("building", input.Building).
+		Set("latitude", input.Coordinates.Latitude).
+		Set("longitude", input.Coordinates.Longitude).
+		Set("capacity", input.Capacity).
+		Where(squirrel.Eq{"id": *input.ID, "company_id": companyID}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: updateAddress - build update query: %v", ErrBuildQuery, err)
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: updateAddress - execute update: %v", ErrExecQuery, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: updateAddress - get rows affected: %v", ErrExecQuery, err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("%w: address_id=%d", ErrAddressNotFound, *input.ID)
+	}
+
+	return nil
+}
+
 func (r *Repository) createWorkingHours(ctx context.Context, tx TxExecutor, companyID int64, wh domain.WorkingHours) error {
 	query, args, err := psqlbuilder.Insert("working_hours").
 		Columns(
@@ -522,7 +666,7 @@ func (r *Repository) updateWorkingHours(ctx context.Context, tx TxExecutor, comp
 }
 
 func (r *Repository) getAddressesByCompanyID(ctx context.Context, companyID int64) ([]domain.Address, error) {
-	query, args, err := psqlbuilder.Select("id", "company_id", "city", "street", "building", "latitude", "longitude").
+	query, args, err := psqlbuilder.Select("id", "company_id", "city", "street", "building", "latitude", "longitude", "capacity").
 		From("addresses").
 		Where(squirrel.Eq{"company_id": companyID}).
 		ToSql()
@@ -549,6 +693,7 @@ func (r *Repository) getAddressesByCompanyID(ctx context.Context, companyID int6
 			&addr.Building,
 			&addr.Coordinates.Latitude,
 			&addr.Coordinates.Longitude,
+			&addr.Capacity,
 		)
 		if err != nil {
 			return nil, err
@@ -557,9 +702,58 @@ func (r *Repository) getAddressesByCompanyID(ctx context.Context, companyID int6
 		addresses = append(addresses, addr)
 	}
 
+	if err := r.loadBayCounts(ctx, addresses); err != nil {
+		return nil, err
+	}
+
 	return addresses, nil
 }
 
+// loadBayCounts заполняет количество постов по типам для адресов
+func (r *Repository) loadBayCounts(ctx context.Context, addresses []domain.Address) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	addressIndex := make(map[int64]int, len(addresses))
+	addressIDs := make([]int64, len(addresses))
+	for i := range addresses {
+		addresses[i].BayCounts = make(map[domain.BayType]int)
+		addressIndex[addresses[i].ID] = i
+		addressIDs[i] = addresses[i].ID
+	}
+
+	query, args, err := psqlbuilder.Select("address_id", "type", "COUNT(*)").
+		From("bays").
+		Where(squirrel.Eq{"address_id": addressIDs}).
+		GroupBy("address_id", "type").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("failed to build select bay counts query: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var addressID int64
+		var bayType domain.BayType
+		var count int
+		if err := rows.Scan(&addressID, &bayType, &count); err != nil {
+			return err
+		}
+		if i, ok := addressIndex[addressID]; ok {
+			addresses[i].BayCounts[bayType] = count
+		}
+	}
+
+	return nil
+}
+
 func (r *Repository) getWorkingHoursByCompanyID(ctx context.Context, companyID int64) (*domain.WorkingHours, error) {
 	query, args, err := psqlbuilder.Select(
 		"monday_is_open", "monday_open_time", "monday_close_time",
@@ -0,0 +1,6 @@
+package outbox
+
+import "github.com/m04kA/SMK-SellerService/pkg/dbmetrics"
+
+// Переиспользуем интерфейсы из dbmetrics
+type DBExecutor = dbmetrics.DBExecutor
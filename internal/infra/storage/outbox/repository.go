@@ -0,0 +1,147 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/pkg/psqlbuilder"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Repository репозиторий исходящих событий для PriceService (таблица priceservice_outbox)
+// События записываются через WriteEvent в транзакции изменения услуги, здесь они выбираются для доставки
+type Repository struct {
+	db DBExecutor
+}
+
+// NewRepository создает новый экземпляр репозитория outbox
+func NewRepository(db DBExecutor) *Repository {
+	return &Repository{db: db}
+}
+
+// WriteEvent записывает событие для PriceService в outbox в той же транзакции tx, что и изменение услуги
+// Используется репозиториями услуг и компаний (удаление компании удаляет её услуги).
+// Событие доставляется фоновым обработчиком и переживает недоступность PriceService
+func WriteEvent(ctx context.Context, tx DBExecutor, eventType domain.ServiceEventType, companyID int64, serviceID int64) error {
+	query, args, err := psqlbuilder.Insert("priceservice_outbox").
+		Columns("event_type", "service_id", "company_id").
+		Values(string(eventType), serviceID, companyID).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: WriteEvent - build insert query: %v", ErrBuildQuery, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: WriteEvent - insert event: %v", ErrExecQuery, err)
+	}
+
+	return nil
+}
+
+// Claim забирает до limit событий, готовых к отправке, и откладывает их повтор на lease
+// Если обработчик упадёт, не отчитавшись, события вернутся в работу после lease.
+// Событие выбирается, только если более ранние события той же услуги уже доставлены,
+// поэтому PriceService получает события одной услуги по порядку.
+// SKIP LOCKED позволяет нескольким экземплярам SellerService разбирать outbox параллельно
+func (r *Repository) Claim(ctx context.Context, limit int, lease time.Duration) ([]domain.ServiceEvent, error) {
+	ready := psqlbuilder.Select("o.id").
+		From("priceservice_outbox o").
+		Where("o.dead_at IS NULL AND o.next_attempt_at <= NOW()").
+		Where("NOT EXISTS (SELECT 1 FROM priceservice_outbox p WHERE p.service_id = o.service_id AND p.id < o.id AND p.dead_at IS NULL)").
+		OrderBy("o.id").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := psqlbuilder.Update("priceservice_outbox").
+		Set("next_attempt_at", squirrel.Expr("NOW() + ? * INTERVAL '1 millisecond'", lease.Milliseconds())).
+		Set("attempts", squirrel.Expr("attempts + 1")).
+		Where(squirrel.Expr("id IN (?)", ready)).
+		Suffix("RETURNING id, event_type, service_id, company_id, attempts, created_at").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: Claim - build update query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Claim - claim events: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.ServiceEvent, 0, limit)
+	for rows.Next() {
+		var event domain.ServiceEvent
+		var eventType string
+		if err := rows.Scan(&event.ID, &eventType, &event.ServiceID, &event.CompanyID, &event.Attempts, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%w: Claim - scan event: %v", ErrScanRow, err)
+		}
+		event.Type = domain.ServiceEventType(eventType)
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: Claim - iterate rows: %v", ErrScanRow, err)
+	}
+
+	return events, nil
+}
+
+// Complete удаляет доставленное событие
+func (r *Repository) Complete(ctx context.Context, id int64) error {
+	query, args, err := psqlbuilder.Delete("priceservice_outbox").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: Complete - build delete query: %v", ErrBuildQuery, err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: Complete - delete event: %v", ErrExecQuery, err)
+	}
+
+	return nil
+}
+
+// Reschedule назначает следующую попытку доставки после временной ошибки
+func (r *Repository) Reschedule(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error {
+	query, args, err := psqlbuilder.Update("priceservice_outbox").
+		Set("next_attempt_at", nextAttemptAt).
+		Set("last_error", lastError).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: Reschedule - build update query: %v", ErrBuildQuery, err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: Reschedule - update event: %v", ErrExecQuery, err)
+	}
+
+	return nil
+}
+
+// MarkDead прекращает доставку события, которое PriceService отклонил
+// Событие остаётся в таблице для разбора и не блокирует следующие события услуги
+func (r *Repository) MarkDead(ctx context.Context, id int64, lastError string) error {
+	query, args, err := psqlbuilder.Update("priceservice_outbox").
+		Set("dead_at", squirrel.Expr("NOW()")).
+		Set("last_error", lastError).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: MarkDead - build update query: %v", ErrBuildQuery, err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: MarkDead - update event: %v", ErrExecQuery, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package schedule
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/pkg/dbmetrics"
+	"github.com/m04kA/SMK-SellerService/pkg/psqlbuilder"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Repository репозиторий занятости адресов
+type Repository struct {
+	db DBExecutor
+}
+
+// NewRepository создает новый экземпляр репозитория занятости
+func NewRepository(db DBExecutor) *Repository {
+	return &Repository{db: db}
+}
+
+// ListBusyIntervals получает занятые интервалы адреса на дату (формат даты "YYYY-MM-DD")
+func (r *Repository) ListBusyIntervals(ctx context.Context, addressID int64, date string) ([]domain.BusyInterval, error) {
+	query, args, err := psqlbuilder.Select("start_time", "end_time").
+		From("address_busy_intervals").
+		Where(squirrel.Eq{"address_id": addressID, "date": date}).
+		OrderBy("start_time").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListBusyIntervals - build select query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListBusyIntervals - execute select: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	intervals := make([]domain.BusyInterval, 0)
+	for rows.Next() {
+		var interval domain.BusyInterval
+		if err := rows.Scan(&interval.Start, &interval.End); err != nil {
+			return nil, fmt.Errorf("%w: ListBusyIntervals - scan interval: %v", ErrScanRow, err)
+		}
+		intervals = append(intervals, interval)
+	}
+
+	return intervals, nil
+}
+
+// ReplaceBusyIntervals заменяет занятость адреса на дату переданным набором интервалов
+func (r *Repository) ReplaceBusyIntervals(ctx context.Context, addressID int64, date string, intervals []domain.BusyInterval) error {
+	tx, err := r.beginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: ReplaceBusyIntervals - begin transaction: %v", ErrTransaction, err)
+	}
+
+	deleteQuery, deleteArgs, err := psqlbuilder.Delete("address_busy_intervals").
+		Where(squirrel.Eq{"address_id": addressID, "date": date}).
+		ToSql()
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: ReplaceBusyIntervals - build delete query: %v", ErrBuildQuery, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, deleteQuery, deleteArgs...); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%w: ReplaceBusyIntervals - delete old intervals: %v", ErrExecQuery, err)
+	}
+
+	if len(intervals) > 0 {
+		insertBuilder := psqlbuilder.Insert("address_busy_intervals").
+			Columns("address_id", "date", "start_time", "end_time")
+		for _, interval := range intervals {
+			insertBuilder = insertBuilder.Values(addressID, date, interval.Start, interval.End)
+		}
+
+		insertQuery, insertArgs, err := insertBuilder.ToSql()
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: ReplaceBusyIntervals - build insert query: %v", ErrBuildQuery, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, insertQuery, insertArgs...); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("%w: ReplaceBusyIntervals - insert intervals: %v", ErrExecQuery, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: ReplaceBusyIntervals - commit transaction: %v", ErrTransaction, err)
+	}
+
+	return nil
+}
+
+func (r *Repository) beginTx(ctx context.Context) (TxExecutor, error) {
+	// Пытаемся привести к TxBeginner интерфейсу (dbmetrics.DB реализует этот интерфейс)
+	if txBeginner, ok := r.db.(TxBeginner); ok {
+		return txBeginner.BeginTx(ctx, nil)
+	}
+
+	// Fallback для обычного *sql.DB
+	if db, ok := r.db.(*sql.DB); ok {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w: beginTx: %v", ErrTransaction, err)
+		}
+		return &dbmetrics.SqlTxWrapper{Tx: tx}, nil
+	}
+
+	return nil, fmt.Errorf("%w: db type not supported", ErrTransaction)
+}
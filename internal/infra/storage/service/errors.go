@@ -1,11 +1,17 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	// ErrServiceNotFound возвращается, когда услуга не найдена в БД
 	ErrServiceNotFound = errors.New("repository: service not found")
 
+	// ErrRevisionNotFound возвращается, когда у услуги нет ревизии на запрошенный момент
+	ErrRevisionNotFound = errors.New("repository: service revision not found")
+
 	// ErrCompanyNotFound возвращается, когда компания не найдена в БД
 	ErrCompanyNotFound = errors.New("repository: company not found")
 
@@ -21,3 +27,21 @@ var (
 	// ErrTransaction возвращается при ошибке работы с транзакцией
 	ErrTransaction = errors.New("repository: transaction error")
 )
+
+// ErrAddressNotOwned возвращается, когда адрес услуги принадлежит другой компании
+var ErrAddressNotOwned = errors.New("repository: address does not belong to company")
+
+// AddressOwnershipError содержит ID адресов, не принадлежащих компании услуги
+type AddressOwnershipError struct {
+	CompanyID  int64
+	AddressIDs []int64
+}
+
+func (e *AddressOwnershipError) Error() string {
+	return fmt.Sprintf("%v: company_id=%d, address_ids=%v", ErrAddressNotOwned, e.CompanyID, e.AddressIDs)
+}
+
+// Unwrap позволяет проверять ошибку через errors.Is(err, ErrAddressNotOwned)
+func (e *AddressOwnershipError) Unwrap() error {
+	return ErrAddressNotOwned
+}
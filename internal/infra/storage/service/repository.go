@@ -4,12 +4,15 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/internal/infra/storage/outbox"
 	"github.com/m04kA/SMK-SellerService/pkg/dbmetrics"
 	"github.com/m04kA/SMK-SellerService/pkg/psqlbuilder"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 )
 
 // Repository репозиторий для работы с услугами
@@ -29,54 +32,27 @@ func (r *Repository) Create(ctx context.Context, companyID int64, input domain.C
 		return nil, fmt.Errorf("%w: Create - begin transaction: %v", ErrTransaction, err)
 	}
 
-	// Создаем услугу
-	query, args, err := psqlbuilder.Insert("services").
-		Columns("company_id", "name", "description", "average_duration").
-		Values(companyID, input.Name, input.Description, input.AverageDuration).
-		Suffix("RETURNING id, created_at, updated_at").
-		ToSql()
-
-	if err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("%w: Create - build insert query: %v", ErrBuildQuery, err)
-	}
-
-	var serviceID int64
-	var createdAt, updatedAt sql.NullTime
-	err = tx.QueryRowContext(ctx, query, args...).Scan(&serviceID, &createdAt, &updatedAt)
+	service, err := r.createInTx(ctx, tx, companyID, input)
 	if err != nil {
 		tx.Rollback()
-		return nil, fmt.Errorf("%w: Create - insert service: %v", ErrExecQuery, err)
-	}
-
-	// Создаем связи с адресами
-	for _, addressID := range input.AddressIDs {
-		err = r.createServiceAddress(ctx, tx, serviceID, addressID)
-		if err != nil {
-			tx.Rollback()
-			return nil, fmt.Errorf("Create - failed to create service address: %w", err)
-		}
+		return nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("%w: Create - commit transaction: %v", ErrTransaction, err)
 	}
 
-	return &domain.Service{
-		ID:              serviceID,
-		CompanyID:       companyID,
-		Name:            input.Name,
-		Description:     input.Description,
-		AverageDuration: input.AverageDuration,
-		AddressIDs:      input.AddressIDs,
-		CreatedAt:       createdAt.Time,
-		UpdatedAt:       updatedAt.Time,
-	}, nil
+	return service, nil
 }
 
 // GetByID получает услугу по ID
 func (r *Repository) GetByID(ctx context.Context, companyID int64, serviceID int64) (*domain.Service, error) {
-	query, args, err := psqlbuilder.Select("id", "company_id", "name", "description", "average_duration", "created_at", "updated_at").
+	return r.getByID(ctx, r.db, companyID, serviceID)
+}
+
+// getByID получает услугу по ID через переданный executor (БД или транзакцию)
+func (r *Repository) getByID(ctx context.Context, db DBExecutor, companyID int64, serviceID int64) (*domain.Service, error) {
+	query, args, err := psqlbuilder.Select("id", "company_id", "name", "description", "translations", "average_duration", "is_active", "template_id", "vehicle_classes", "base_price", "base_currency", "created_at", "updated_at").
 		From("services").
 		Where(squirrel.Eq{"id": serviceID, "company_id": companyID}).
 		ToSql()
@@ -86,14 +62,21 @@ func (r *Repository) GetByID(ctx context.Context, companyID int64, serviceID int
 	}
 
 	var service domain.Service
+	var vehicleClasses pq.StringArray
 	var createdAt, updatedAt sql.NullTime
 
-	err = r.db.QueryRowContext(ctx, query, args...).Scan(
+	err = db.QueryRowContext(ctx, query, args...).Scan(
 		&service.ID,
 		&service.CompanyID,
 		&service.Name,
 		&service.Description,
+		&service.Translations,
 		&service.AverageDuration,
+		&service.IsActive,
+		&service.TemplateID,
+		&vehicleClasses,
+		&service.BasePrice,
+		&service.BaseCurrency,
 		&createdAt,
 		&updatedAt,
 	)
@@ -105,11 +88,12 @@ func (r *Repository) GetByID(ctx context.Context, companyID int64, serviceID int
 		return nil, fmt.Errorf("%w: GetByID - scan service: %v", ErrScanRow, err)
 	}
 
+	service.VehicleClasses = vehicleClasses
 	service.CreatedAt = createdAt.Time
 	service.UpdatedAt = updatedAt.Time
 
 	// Загружаем ID адресов
-	addressIDs, err := r.getServiceAddressIDs(ctx, serviceID)
+	addressIDs, err := r.getServiceAddressIDs(ctx, db, serviceID)
 	if err != nil {
 		return nil, fmt.Errorf("GetByID - failed to get address ids: %w", err)
 	}
@@ -119,12 +103,22 @@ func (r *Repository) GetByID(ctx context.Context, companyID int64, serviceID int
 }
 
 // ListByCompany получает список услуг компании
-func (r *Repository) ListByCompany(ctx context.Context, companyID int64) ([]domain.Service, error) {
-	query, args, err := psqlbuilder.Select("id", "company_id", "name", "description", "average_duration", "created_at", "updated_at").
+func (r *Repository) ListByCompany(ctx context.Context, companyID int64, filter domain.ServiceFilter) ([]domain.Service, error) {
+	selectBuilder := psqlbuilder.Select("id", "company_id", "name", "description", "translations", "average_duration", "is_active", "template_id", "vehicle_classes", "base_price", "base_currency", "created_at", "updated_at").
 		From("services").
 		Where(squirrel.Eq{"company_id": companyID}).
-		OrderBy("created_at DESC").
-		ToSql()
+		OrderBy("created_at DESC")
+
+	// Применяем фильтры
+	if filter.OnlyActive {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"is_active": true})
+	}
+	if filter.VehicleClass != nil {
+		// Пустой список классов означает, что услуга подходит для любого автомобиля
+		selectBuilder = selectBuilder.Where("(cardinality(vehicle_classes) = 0 OR ? = ANY(vehicle_classes))", *filter.VehicleClass)
+	}
+
+	query, args, err := selectBuilder.ToSql()
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to build select query: %w", err)
@@ -139,6 +133,7 @@ func (r *Repository) ListByCompany(ctx context.Context, companyID int64) ([]doma
 	services := make([]domain.Service, 0)
 	for rows.Next() {
 		var service domain.Service
+		var vehicleClasses pq.StringArray
 		var createdAt, updatedAt sql.NullTime
 
 		err := rows.Scan(
@@ -146,7 +141,13 @@ func (r *Repository) ListByCompany(ctx context.Context, companyID int64) ([]doma
 			&service.CompanyID,
 			&service.Name,
 			&service.Description,
+			&service.Translations,
 			&service.AverageDuration,
+			&service.IsActive,
+			&service.TemplateID,
+			&vehicleClasses,
+			&service.BasePrice,
+			&service.BaseCurrency,
 			&createdAt,
 			&updatedAt,
 		)
@@ -154,11 +155,12 @@ func (r *Repository) ListByCompany(ctx context.Context, companyID int64) ([]doma
 			return nil, fmt.Errorf("failed to scan service: %w", err)
 		}
 
+		service.VehicleClasses = vehicleClasses
 		service.CreatedAt = createdAt.Time
 		service.UpdatedAt = updatedAt.Time
 
 		// Загружаем ID адресов для каждой услуги
-		addressIDs, err := r.getServiceAddressIDs(ctx, service.ID)
+		addressIDs, err := r.getServiceAddressIDs(ctx, r.db, service.ID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get address ids: %w", err)
 		}
@@ -170,6 +172,75 @@ func (r *Repository) ListByCompany(ctx context.Context, companyID int64) ([]doma
 	return services, nil
 }
 
+// ListBasePrices возвращает базовые цены активных услуг компании
+// Услуги без базовой цены в результат не попадают
+func (r *Repository) ListBasePrices(ctx context.Context, companyID int64, serviceIDs []int64) ([]domain.ServiceBasePrice, error) {
+	query, args, err := psqlbuilder.Select("id", "base_price").
+		Column(squirrel.Expr("COALESCE(base_currency, ?)", domain.DefaultCurrency)).
+		From("services").
+		Where(squirrel.Eq{"company_id": companyID, "id": serviceIDs, "is_active": true}).
+		Where(squirrel.NotEq{"base_price": nil}).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListBasePrices - build select query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListBasePrices - query base prices: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	prices := make([]domain.ServiceBasePrice, 0, len(serviceIDs))
+	for rows.Next() {
+		var price domain.ServiceBasePrice
+		if err := rows.Scan(&price.ServiceID, &price.Price, &price.Currency); err != nil {
+			return nil, fmt.Errorf("%w: ListBasePrices - scan base price: %v", ErrScanRow, err)
+		}
+		prices = append(prices, price)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: ListBasePrices - iterate rows: %v", ErrScanRow, err)
+	}
+
+	return prices, nil
+}
+
+// ListActiveIDsByCompanies возвращает ID активных услуг, сгруппированные по компаниям
+// Компании без активных услуг в результат не попадают
+func (r *Repository) ListActiveIDsByCompanies(ctx context.Context, companyIDs []int64) (map[int64][]int64, error) {
+	query, args, err := psqlbuilder.Select("company_id", "id").
+		From("services").
+		Where(squirrel.Eq{"company_id": companyIDs, "is_active": true}).
+		OrderBy("company_id", "id").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListActiveIDsByCompanies - build select query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListActiveIDsByCompanies - query service ids: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	serviceIDs := make(map[int64][]int64, len(companyIDs))
+	for rows.Next() {
+		var companyID, serviceID int64
+		if err := rows.Scan(&companyID, &serviceID); err != nil {
+			return nil, fmt.Errorf("%w: ListActiveIDsByCompanies - scan service id: %v", ErrScanRow, err)
+		}
+		serviceIDs[companyID] = append(serviceIDs[companyID], serviceID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: ListActiveIDsByCompanies - iterate rows: %v", ErrScanRow, err)
+	}
+
+	return serviceIDs, nil
+}
+
 // Update обновляет услугу
 func (r *Repository) Update(ctx context.Context, companyID int64, serviceID int64, input domain.UpdateServiceInput) (*domain.Service, error) {
 	tx, err := r.beginTx(ctx)
@@ -177,6 +248,244 @@ func (r *Repository) Update(ctx context.Context, companyID int64, serviceID int6
 		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
 
+	if err := r.updateInTx(ctx, tx, companyID, serviceID, input); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	// Возвращаем обновленную услугу
+	return r.GetByID(ctx, companyID, serviceID)
+}
+
+// ListRevisions получает историю услуги от первой ревизии к последней
+// История доступна и после удаления услуги
+func (r *Repository) ListRevisions(ctx context.Context, companyID int64, serviceID int64) ([]domain.ServiceRevision, error) {
+	query, args, err := revisionSelect().
+		Where(squirrel.Eq{"r.service_id": serviceID, "r.company_id": companyID}).
+		OrderBy("r.revision").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListRevisions - build select query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: ListRevisions - select revisions: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	revisions := make([]domain.ServiceRevision, 0)
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, fmt.Errorf("%w: ListRevisions - scan revision: %v", ErrScanRow, err)
+		}
+		revisions = append(revisions, *revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%w: ListRevisions - iterate revisions: %v", ErrScanRow, err)
+	}
+
+	if len(revisions) == 0 {
+		return nil, ErrServiceNotFound
+	}
+
+	return revisions, nil
+}
+
+// GetAsOf получает услугу в состоянии, действовавшем на момент asOf
+func (r *Repository) GetAsOf(ctx context.Context, companyID int64, serviceID int64, asOf time.Time) (*domain.ServiceRevision, error) {
+	query, args, err := revisionSelect().
+		Where(squirrel.Eq{"r.service_id": serviceID, "r.company_id": companyID}).
+		Where(squirrel.LtOrEq{"r.created_at": asOf}).
+		OrderBy("r.revision DESC").
+		Limit(1).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetAsOf - build select query: %v", ErrBuildQuery, err)
+	}
+
+	revision, err := scanRevision(r.db.QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetAsOf - scan revision: %v", ErrScanRow, err)
+	}
+
+	// Услуга была удалена к моменту asOf
+	if revision.Deleted {
+		return nil, ErrRevisionNotFound
+	}
+
+	return revision, nil
+}
+
+// Delete удаляет услугу
+func (r *Repository) Delete(ctx context.Context, companyID int64, serviceID int64) error {
+	tx, err := r.beginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: Delete - begin transaction: %v", ErrTransaction, err)
+	}
+
+	if err := r.delete(ctx, tx, companyID, serviceID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%w: Delete - commit transaction: %v", ErrTransaction, err)
+	}
+
+	return nil
+}
+
+// Batch выполняет набор операций над услугами компании в одной транзакции
+// В атомарном режиме первая ошибка откатывает всю транзакцию, остальные операции не выполняются.
+// В неатомарном режиме каждая операция изолирована SAVEPOINT'ом: ошибочные откатываются, успешные фиксируются.
+func (r *Repository) Batch(ctx context.Context, companyID int64, operations []domain.ServiceBatchOperation, atomic bool) (*domain.ServiceBatchOutcome, error) {
+	tx, err := r.beginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Batch - begin transaction: %v", ErrTransaction, err)
+	}
+
+	results := make([]domain.ServiceBatchResult, len(operations))
+	for i, op := range operations {
+		if !atomic {
+			if _, err := tx.ExecContext(ctx, "SAVEPOINT batch_operation"); err != nil {
+				tx.Rollback()
+				return nil, fmt.Errorf("%w: Batch - create savepoint: %v", ErrTransaction, err)
+			}
+		}
+
+		service, opErr := r.applyBatchOperation(ctx, tx, companyID, op)
+		results[i] = domain.ServiceBatchResult{
+			Executed: true,
+			Service:  service,
+			Err:      opErr,
+		}
+
+		if opErr == nil {
+			if !atomic {
+				if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT batch_operation"); err != nil {
+					tx.Rollback()
+					return nil, fmt.Errorf("%w: Batch - release savepoint: %v", ErrTransaction, err)
+				}
+			}
+			continue
+		}
+
+		if atomic {
+			tx.Rollback()
+			return &domain.ServiceBatchOutcome{Results: results, Committed: false}, nil
+		}
+
+		if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_operation"); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("%w: Batch - rollback to savepoint: %v", ErrTransaction, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%w: Batch - commit transaction: %v", ErrTransaction, err)
+	}
+
+	return &domain.ServiceBatchOutcome{Results: results, Committed: true}, nil
+}
+
+// Helper methods
+
+// applyBatchOperation выполняет одну операцию пакета в транзакции
+func (r *Repository) applyBatchOperation(ctx context.Context, tx TxExecutor, companyID int64, op domain.ServiceBatchOperation) (*domain.Service, error) {
+	switch op.Type {
+	case domain.ServiceBatchCreate:
+		return r.createInTx(ctx, tx, companyID, *op.Create)
+	case domain.ServiceBatchUpdate:
+		if err := r.updateInTx(ctx, tx, companyID, *op.ServiceID, *op.Update); err != nil {
+			return nil, err
+		}
+		return r.getByID(ctx, tx, companyID, *op.ServiceID)
+	case domain.ServiceBatchDelete:
+		return nil, r.delete(ctx, tx, companyID, *op.ServiceID)
+	default:
+		return nil, fmt.Errorf("%w: Batch - unknown operation type %q", ErrBuildQuery, op.Type)
+	}
+}
+
+// createInTx создает услугу и её связи с адресами в транзакции
+func (r *Repository) createInTx(ctx context.Context, tx TxExecutor, companyID int64, input domain.CreateServiceInput) (*domain.Service, error) {
+	vehicleClasses := input.VehicleClasses
+	if vehicleClasses == nil {
+		vehicleClasses = []string{}
+	}
+
+	// Создаем услугу
+	query, args, err := psqlbuilder.Insert("services").
+		Columns("company_id", "name", "description", "translations", "average_duration", "is_active", "template_id", "vehicle_classes", "base_price", "base_currency").
+		Values(companyID, input.Name, input.Description, input.Translations, input.AverageDuration, input.IsActive, input.TemplateID, pq.Array(vehicleClasses), input.BasePrice, input.BaseCurrency).
+		Suffix("RETURNING id, created_at, updated_at").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: Create - build insert query: %v", ErrBuildQuery, err)
+	}
+
+	var serviceID int64
+	var createdAt, updatedAt sql.NullTime
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&serviceID, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Create - insert service: %v", ErrExecQuery, err)
+	}
+
+	// Проверяем, что все адреса принадлежат компании
+	if err := r.validateServiceAddresses(ctx, tx, companyID, input.AddressIDs); err != nil {
+		return nil, fmt.Errorf("Create - validate service addresses: %w", err)
+	}
+
+	// Создаем связи с адресами
+	for _, addressID := range input.AddressIDs {
+		err = r.createServiceAddress(ctx, tx, serviceID, addressID)
+		if err != nil {
+			return nil, fmt.Errorf("Create - failed to create service address: %w", err)
+		}
+	}
+
+	// Первая ревизия истории услуги
+	if err := r.writeRevision(ctx, tx, serviceID); err != nil {
+		return nil, fmt.Errorf("Create - failed to write revision: %w", err)
+	}
+
+	// PriceService узнает о новой услуге после коммита
+	if err := outbox.WriteEvent(ctx, tx, domain.ServiceEventCreated, companyID, serviceID); err != nil {
+		return nil, fmt.Errorf("Create - failed to write event: %w", err)
+	}
+
+	return &domain.Service{
+		ID:              serviceID,
+		CompanyID:       companyID,
+		Name:            input.Name,
+		Description:     input.Description,
+		Translations:    input.Translations,
+		AverageDuration: input.AverageDuration,
+		AddressIDs:      input.AddressIDs,
+		IsActive:        input.IsActive,
+		TemplateID:      input.TemplateID,
+		VehicleClasses:  vehicleClasses,
+		BasePrice:       input.BasePrice,
+		BaseCurrency:    input.BaseCurrency,
+		CreatedAt:       createdAt.Time,
+		UpdatedAt:       updatedAt.Time,
+	}, nil
+}
+
+// updateInTx обновляет услугу и её связи с адресами в транзакции
+func (r *Repository) updateInTx(ctx context.Context, tx TxExecutor, companyID int64, serviceID int64, input domain.UpdateServiceInput) error {
 	// Обновляем основные поля услуги
 	updateBuilder := psqlbuilder.Update("services").Where(squirrel.Eq{"id": serviceID, "company_id": companyID})
 
@@ -186,71 +495,92 @@ func (r *Repository) Update(ctx context.Context, companyID int64, serviceID int6
 	if input.Description != nil {
 		updateBuilder = updateBuilder.Set("description", *input.Description)
 	}
+	if input.Translations != nil {
+		updateBuilder = updateBuilder.Set("translations", input.Translations)
+	}
 	if input.AverageDuration != nil {
 		updateBuilder = updateBuilder.Set("average_duration", *input.AverageDuration)
 	}
+	if input.IsActive != nil {
+		updateBuilder = updateBuilder.Set("is_active", *input.IsActive)
+	}
+	if input.VehicleClasses != nil {
+		updateBuilder = updateBuilder.Set("vehicle_classes", pq.Array(input.VehicleClasses))
+	}
+	if input.ClearBasePrice {
+		// Без базовой цены при недоступности PriceService цена услуги не показывается
+		updateBuilder = updateBuilder.Set("base_price", nil).Set("base_currency", nil)
+	}
+	if input.BasePrice != nil {
+		updateBuilder = updateBuilder.Set("base_price", *input.BasePrice)
+	}
+	if input.BaseCurrency != nil {
+		updateBuilder = updateBuilder.Set("base_currency", *input.BaseCurrency)
+	}
 
 	query, args, err := updateBuilder.ToSql()
 	if err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("failed to build update query: %w", err)
+		return fmt.Errorf("failed to build update query: %w", err)
 	}
 
 	result, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("failed to update service: %w", err)
+		return fmt.Errorf("failed to update service: %w", err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		tx.Rollback()
-		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 
 	if rowsAffected == 0 {
-		tx.Rollback()
-		return nil, fmt.Errorf("service not found")
+		return ErrServiceNotFound
 	}
 
 	// Обновляем адреса, если переданы
 	if len(input.AddressIDs) > 0 {
+		// Проверяем, что все адреса принадлежат компании
+		if err := r.validateServiceAddresses(ctx, tx, companyID, input.AddressIDs); err != nil {
+			return fmt.Errorf("Update - validate service addresses: %w", err)
+		}
+
 		// Удаляем старые связи
 		deleteQuery, deleteArgs, err := psqlbuilder.Delete("service_addresses").
 			Where(squirrel.Eq{"service_id": serviceID}).
 			ToSql()
 
 		if err != nil {
-			tx.Rollback()
-			return nil, fmt.Errorf("failed to build delete service addresses query: %w", err)
+			return fmt.Errorf("failed to build delete service addresses query: %w", err)
 		}
 
 		_, err = tx.ExecContext(ctx, deleteQuery, deleteArgs...)
 		if err != nil {
-			tx.Rollback()
-			return nil, fmt.Errorf("failed to delete old service addresses: %w", err)
+			return fmt.Errorf("failed to delete old service addresses: %w", err)
 		}
 
 		// Создаем новые связи
 		for _, addressID := range input.AddressIDs {
 			err = r.createServiceAddress(ctx, tx, serviceID, addressID)
 			if err != nil {
-				tx.Rollback()
-				return nil, fmt.Errorf("failed to create service address: %w", err)
+				return fmt.Errorf("failed to create service address: %w", err)
 			}
 		}
 	}
 
-	if err := tx.Commit(); err != nil {
-		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	// Сохраняем новое состояние в историю услуги
+	if err := r.writeRevision(ctx, tx, serviceID); err != nil {
+		return fmt.Errorf("failed to write revision: %w", err)
 	}
 
-	// Возвращаем обновленную услугу
-	return r.GetByID(ctx, companyID, serviceID)
+	if err := outbox.WriteEvent(ctx, tx, domain.ServiceEventUpdated, companyID, serviceID); err != nil {
+		return fmt.Errorf("failed to write event: %w", err)
+	}
+
+	return nil
 }
 
-// Delete удаляет услугу
-func (r *Repository) Delete(ctx context.Context, companyID int64, serviceID int64) error {
+// delete удаляет услугу в транзакции
+func (r *Repository) delete(ctx context.Context, tx TxExecutor, companyID int64, serviceID int64) error {
 	query, args, err := psqlbuilder.Delete("services").
 		Where(squirrel.Eq{"id": serviceID, "company_id": companyID}).
 		ToSql()
@@ -259,7 +589,7 @@ func (r *Repository) Delete(ctx context.Context, companyID int64, serviceID int6
 		return fmt.Errorf("%w: Delete - build delete query: %v", ErrBuildQuery, err)
 	}
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("%w: Delete - execute delete: %v", ErrExecQuery, err)
 	}
@@ -273,11 +603,18 @@ func (r *Repository) Delete(ctx context.Context, companyID int64, serviceID int6
 		return ErrServiceNotFound
 	}
 
+	if err := WriteTombstone(ctx, tx, serviceID); err != nil {
+		return fmt.Errorf("Delete - failed to write revision: %w", err)
+	}
+
+	// PriceService удалит цены услуги после коммита
+	if err := outbox.WriteEvent(ctx, tx, domain.ServiceEventDeleted, companyID, serviceID); err != nil {
+		return fmt.Errorf("Delete - failed to write event: %w", err)
+	}
+
 	return nil
 }
 
-// Helper methods
-
 func (r *Repository) beginTx(ctx context.Context) (TxExecutor, error) {
 	// Пытаемся привести к TxBeginner интерфейсу (dbmetrics.DB реализует этот интерфейс)
 	if txBeginner, ok := r.db.(TxBeginner); ok {
@@ -296,6 +633,62 @@ func (r *Repository) beginTx(ctx context.Context) (TxExecutor, error) {
 	return nil, fmt.Errorf("%w: db type not supported", ErrTransaction)
 }
 
+// validateServiceAddresses проверяет в рамках транзакции, что все адреса принадлежат компании
+// Строки адресов блокируются FOR SHARE, чтобы их нельзя было удалить до коммита
+func (r *Repository) validateServiceAddresses(ctx context.Context, tx TxExecutor, companyID int64, addressIDs []int64) error {
+	if len(addressIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := psqlbuilder.Select("id").
+		From("addresses").
+		Where(squirrel.Eq{"company_id": companyID, "id": addressIDs}).
+		Suffix("FOR SHARE").
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: validateServiceAddresses - build select query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: validateServiceAddresses - select addresses: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	owned := make(map[int64]struct{}, len(addressIDs))
+	for rows.Next() {
+		var addressID int64
+		if err := rows.Scan(&addressID); err != nil {
+			return fmt.Errorf("%w: validateServiceAddresses - scan address id: %v", ErrScanRow, err)
+		}
+		owned[addressID] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%w: validateServiceAddresses - iterate rows: %v", ErrScanRow, err)
+	}
+
+	// Собираем адреса чужих компаний (и несуществующие) без дублей
+	foreign := make([]int64, 0)
+	seen := make(map[int64]struct{}, len(addressIDs))
+	for _, addressID := range addressIDs {
+		if _, ok := seen[addressID]; ok {
+			continue
+		}
+		seen[addressID] = struct{}{}
+
+		if _, ok := owned[addressID]; !ok {
+			foreign = append(foreign, addressID)
+		}
+	}
+
+	if len(foreign) > 0 {
+		return &AddressOwnershipError{CompanyID: companyID, AddressIDs: foreign}
+	}
+
+	return nil
+}
+
 func (r *Repository) createServiceAddress(ctx context.Context, tx TxExecutor, serviceID int64, addressID int64) error {
 	query, args, err := psqlbuilder.Insert("service_addresses").
 		Columns("service_id", "address_id").
@@ -310,7 +703,7 @@ func (r *Repository) createServiceAddress(ctx context.Context, tx TxExecutor, se
 	return err
 }
 
-func (r *Repository) getServiceAddressIDs(ctx context.Context, serviceID int64) ([]int64, error) {
+func (r *Repository) getServiceAddressIDs(ctx context.Context, db DBExecutor, serviceID int64) ([]int64, error) {
 	query, args, err := psqlbuilder.Select("address_id").
 		From("service_addresses").
 		Where(squirrel.Eq{"service_id": serviceID}).
@@ -320,7 +713,7 @@ func (r *Repository) getServiceAddressIDs(ctx context.Context, serviceID int64)
 		return nil, fmt.Errorf("failed to build select query: %w", err)
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -338,3 +731,111 @@ func (r *Repository) getServiceAddressIDs(ctx context.Context, serviceID int64)
 
 	return addressIDs, nil
 }
+
+// writeRevision записывает текущее состояние услуги (вместе с адресами) в историю
+// Вызывается в транзакции после изменения: строка услуги уже заблокирована, поэтому номера ревизий не конфликтуют
+func (r *Repository) writeRevision(ctx context.Context, tx TxExecutor, serviceID int64) error {
+	snapshot := psqlbuilder.Select("s.id", "s.company_id").
+		Column("(SELECT COALESCE(MAX(sr.revision), 0) + 1 FROM service_revisions sr WHERE sr.service_id = s.id)").
+		Columns("s.name", "s.description", "s.translations", "s.average_duration").
+		Column("ARRAY(SELECT sa.address_id FROM service_addresses sa WHERE sa.service_id = s.id ORDER BY sa.address_id)").
+		Columns("s.is_active", "s.template_id", "s.vehicle_classes", "s.base_price", "s.base_currency").
+		From("services s").
+		Where(squirrel.Eq{"s.id": serviceID})
+
+	query, args, err := psqlbuilder.Insert("service_revisions").
+		Columns("service_id", "company_id", "revision", "name", "description", "translations", "average_duration",
+			"address_ids", "is_active", "template_id", "vehicle_classes", "base_price", "base_currency").
+		Select(snapshot).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: writeRevision - build insert query: %v", ErrBuildQuery, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: writeRevision - insert revision: %v", ErrExecQuery, err)
+	}
+
+	return nil
+}
+
+// WriteTombstone записывает ревизию-надгробие с последним состоянием удаляемой услуги
+// Вызывается в транзакции удаления, строка услуги должна быть заблокирована до коммита.
+// Используется репозиториями услуг и компаний (удаление компании удаляет её услуги)
+func WriteTombstone(ctx context.Context, tx TxExecutor, serviceID int64) error {
+	last := psqlbuilder.Select("service_id", "company_id", "revision + 1", "name", "description", "translations",
+		"average_duration", "address_ids", "is_active", "template_id", "vehicle_classes", "base_price", "base_currency", "TRUE").
+		From("service_revisions").
+		Where(squirrel.Eq{"service_id": serviceID}).
+		OrderBy("revision DESC").
+		Limit(1)
+
+	query, args, err := psqlbuilder.Insert("service_revisions").
+		Columns("service_id", "company_id", "revision", "name", "description", "translations", "average_duration",
+			"address_ids", "is_active", "template_id", "vehicle_classes", "base_price", "base_currency", "deleted").
+		Select(last).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: WriteTombstone - build insert query: %v", ErrBuildQuery, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("%w: WriteTombstone - insert revision: %v", ErrExecQuery, err)
+	}
+
+	return nil
+}
+
+// revisionSelect запрос ревизий услуги
+// Дата создания услуги берётся из первой ревизии, так как услуга могла быть удалена
+func revisionSelect() squirrel.SelectBuilder {
+	return psqlbuilder.Select("r.revision", "r.service_id", "r.company_id", "r.name", "r.description", "r.translations",
+		"r.average_duration", "r.address_ids", "r.is_active", "r.template_id", "r.vehicle_classes",
+		"r.base_price", "r.base_currency", "r.deleted").
+		Column("(SELECT MIN(f.created_at) FROM service_revisions f WHERE f.service_id = r.service_id)").
+		Column("r.created_at").
+		From("service_revisions r")
+}
+
+// rowScanner общий интерфейс для *sql.Row и *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanRevision(row rowScanner) (*domain.ServiceRevision, error) {
+	var revision domain.ServiceRevision
+	var addressIDs pq.Int64Array
+	var vehicleClasses pq.StringArray
+	var serviceCreatedAt sql.NullTime
+
+	err := row.Scan(
+		&revision.Revision,
+		&revision.Service.ID,
+		&revision.Service.CompanyID,
+		&revision.Service.Name,
+		&revision.Service.Description,
+		&revision.Service.Translations,
+		&revision.Service.AverageDuration,
+		&addressIDs,
+		&revision.Service.IsActive,
+		&revision.Service.TemplateID,
+		&vehicleClasses,
+		&revision.Service.BasePrice,
+		&revision.Service.BaseCurrency,
+		&revision.Deleted,
+		&serviceCreatedAt,
+		&revision.ValidFrom,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	revision.Service.AddressIDs = addressIDs
+	revision.Service.VehicleClasses = vehicleClasses
+	revision.Service.CreatedAt = serviceCreatedAt.Time
+	revision.Service.UpdatedAt = revision.ValidFrom
+
+	return &revision, nil
+}
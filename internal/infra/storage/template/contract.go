@@ -0,0 +1,8 @@
+package template
+
+import (
+	"github.com/m04kA/SMK-SellerService/pkg/dbmetrics"
+)
+
+// Переиспользуем интерфейсы из dbmetrics
+type DBExecutor = dbmetrics.DBExecutor
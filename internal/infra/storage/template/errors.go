@@ -0,0 +1,17 @@
+package template
+
+import "errors"
+
+var (
+	// ErrTemplateNotFound возвращается, когда шаблон услуги не найден в БД
+	ErrTemplateNotFound = errors.New("repository: service template not found")
+
+	// ErrBuildQuery возвращается при ошибке построения SQL запроса
+	ErrBuildQuery = errors.New("repository: failed to build SQL query")
+
+	// ErrExecQuery возвращается при ошибке выполнения SQL запроса
+	ErrExecQuery = errors.New("repository: failed to execute SQL query")
+
+	// ErrScanRow возвращается при ошибке сканирования строки из БД
+	ErrScanRow = errors.New("repository: failed to scan row")
+)
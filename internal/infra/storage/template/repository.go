@@ -0,0 +1,211 @@
+package template
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/pkg/psqlbuilder"
+
+	"github.com/Masterminds/squirrel"
+)
+
+// Repository репозиторий для работы с шаблонами услуг
+type Repository struct {
+	db DBExecutor
+}
+
+// NewRepository создает новый экземпляр репозитория шаблонов услуг
+func NewRepository(db DBExecutor) *Repository {
+	return &Repository{db: db}
+}
+
+// Create создает новый шаблон услуги
+func (r *Repository) Create(ctx context.Context, input domain.CreateServiceTemplateInput) (*domain.ServiceTemplate, error) {
+	query, args, err := psqlbuilder.Insert("service_templates").
+		Columns("name", "description", "default_duration", "category").
+		Values(input.Name, input.Description, input.DefaultDuration, input.Category).
+		Suffix("RETURNING id, created_at, updated_at").
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: Create - build insert query: %v", ErrBuildQuery, err)
+	}
+
+	var templateID int64
+	var createdAt, updatedAt sql.NullTime
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(&templateID, &createdAt, &updatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Create - insert service template: %v", ErrExecQuery, err)
+	}
+
+	return &domain.ServiceTemplate{
+		ID:              templateID,
+		Name:            input.Name,
+		Description:     input.Description,
+		DefaultDuration: input.DefaultDuration,
+		Category:        input.Category,
+		CreatedAt:       createdAt.Time,
+		UpdatedAt:       updatedAt.Time,
+	}, nil
+}
+
+// GetByID получает шаблон услуги по ID
+func (r *Repository) GetByID(ctx context.Context, id int64) (*domain.ServiceTemplate, error) {
+	query, args, err := psqlbuilder.Select("id", "name", "description", "default_duration", "category", "created_at", "updated_at").
+		From("service_templates").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetByID - build select query: %v", ErrBuildQuery, err)
+	}
+
+	var template domain.ServiceTemplate
+	var createdAt, updatedAt sql.NullTime
+
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(
+		&template.ID,
+		&template.Name,
+		&template.Description,
+		&template.DefaultDuration,
+		&template.Category,
+		&createdAt,
+		&updatedAt,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, ErrTemplateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetByID - scan service template: %v", ErrScanRow, err)
+	}
+
+	template.CreatedAt = createdAt.Time
+	template.UpdatedAt = updatedAt.Time
+
+	return &template, nil
+}
+
+// List получает список шаблонов услуг с фильтрацией
+func (r *Repository) List(ctx context.Context, filter domain.ServiceTemplateFilter) ([]domain.ServiceTemplate, error) {
+	selectBuilder := psqlbuilder.Select("id", "name", "description", "default_duration", "category", "created_at", "updated_at").
+		From("service_templates").
+		OrderBy("category", "name")
+
+	// Применяем фильтры
+	if filter.Category != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{"category": *filter.Category})
+	}
+
+	query, args, err := selectBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: List - build select query: %v", ErrBuildQuery, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: List - select service templates: %v", ErrExecQuery, err)
+	}
+	defer rows.Close()
+
+	templates := make([]domain.ServiceTemplate, 0)
+	for rows.Next() {
+		var template domain.ServiceTemplate
+		var createdAt, updatedAt sql.NullTime
+
+		err := rows.Scan(
+			&template.ID,
+			&template.Name,
+			&template.Description,
+			&template.DefaultDuration,
+			&template.Category,
+			&createdAt,
+			&updatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: List - scan service template: %v", ErrScanRow, err)
+		}
+
+		template.CreatedAt = createdAt.Time
+		template.UpdatedAt = updatedAt.Time
+
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+// Update обновляет шаблон услуги
+func (r *Repository) Update(ctx context.Context, id int64, input domain.UpdateServiceTemplateInput) (*domain.ServiceTemplate, error) {
+	// Пустое обновление: просто возвращаем текущее состояние
+	if input.Name == nil && input.Description == nil && input.DefaultDuration == nil && input.Category == nil {
+		return r.GetByID(ctx, id)
+	}
+
+	updateBuilder := psqlbuilder.Update("service_templates").Where(squirrel.Eq{"id": id})
+
+	if input.Name != nil {
+		updateBuilder = updateBuilder.Set("name", *input.Name)
+	}
+	if input.Description != nil {
+		updateBuilder = updateBuilder.Set("description", *input.Description)
+	}
+	if input.DefaultDuration != nil {
+		updateBuilder = updateBuilder.Set("default_duration", *input.DefaultDuration)
+	}
+	if input.Category != nil {
+		updateBuilder = updateBuilder.Set("category", *input.Category)
+	}
+
+	query, args, err := updateBuilder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("%w: Update - build update query: %v", ErrBuildQuery, err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Update - execute update: %v", ErrExecQuery, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("%w: Update - get rows affected: %v", ErrExecQuery, err)
+	}
+
+	if rowsAffected == 0 {
+		return nil, ErrTemplateNotFound
+	}
+
+	// Возвращаем обновленный шаблон
+	return r.GetByID(ctx, id)
+}
+
+// Delete удаляет шаблон услуги
+// Созданные из шаблона услуги сохраняются, template_id у них обнуляется (ON DELETE SET NULL)
+func (r *Repository) Delete(ctx context.Context, id int64) error {
+	query, args, err := psqlbuilder.Delete("service_templates").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+
+	if err != nil {
+		return fmt.Errorf("%w: Delete - build delete query: %v", ErrBuildQuery, err)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("%w: Delete - execute delete: %v", ErrExecQuery, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: Delete - get rows affected: %v", ErrExecQuery, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrTemplateNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package priceservice
+
+import (
+	"context"
+	"sync"
+)
+
+// CompanyPricesResult результат расчёта цен одной компании в пакетном запросе
+type CompanyPricesResult struct {
+	CompanyID int64
+	Prices    *CalculatePricesResponse
+	Err       error // Ошибка с graceful degradation, как у CalculatePricesWithGracefulDegradation
+}
+
+// CalculatePricesBatch рассчитывает цены для нескольких компаний
+// PriceService считает цены одной компании за запрос, поэтому запросы выполняются параллельно,
+// не более batchConcurrency одновременно. Для каждой компании действуют бюджет и базовые цены,
+// как в CalculatePricesWithGracefulDegradation. Результаты возвращаются в порядке reqs
+func (c *CompositeClient) CalculatePricesBatch(ctx context.Context, reqs []*CalculatePricesRequest) []CompanyPricesResult {
+	results := make([]CompanyPricesResult, len(reqs))
+	sem := make(chan struct{}, c.batchConcurrency)
+	var wg sync.WaitGroup
+
+	for i, req := range reqs {
+		results[i].CompanyID = req.CompanyID
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, req *CalculatePricesRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i].Prices, results[i].Err = c.CalculatePricesWithGracefulDegradation(ctx, req)
+		}(i, req)
+	}
+
+	wg.Wait()
+	return results
+}
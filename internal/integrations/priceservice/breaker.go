@@ -0,0 +1,191 @@
+package priceservice
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// BreakerState состояние circuit breaker
+type BreakerState int
+
+const (
+	// BreakerClosed - запросы проходят, ошибки подсчитываются
+	BreakerClosed BreakerState = iota
+	// BreakerHalfOpen - после cool-down пропускаются пробные запросы
+	BreakerHalfOpen
+	// BreakerOpen - запросы не выполняются, сразу применяется graceful degradation
+	BreakerOpen
+)
+
+// String возвращает название состояния для логов и метрик
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerHalfOpen:
+		return "half_open"
+	case BreakerOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerConfig настройки circuit breaker
+type BreakerConfig struct {
+	FailureThreshold int           // Число ошибок подряд, после которого breaker открывается
+	CoolDown         time.Duration // Время в открытом состоянии до пробных запросов
+	HalfOpenMaxCalls int           // Сколько пробных запросов выполняется одновременно в half-open
+}
+
+// callResult итог вызова PriceService для circuit breaker
+type callResult int
+
+const (
+	resultSuccess callResult = iota // PriceService ответил (в том числе 400/404)
+	resultFailure                   // PriceService недоступен или ответил ошибкой сервера
+	resultIgnored                   // Вызов отменён вызывающим, о здоровье PriceService ничего не известно
+)
+
+// Breaker circuit breaker вокруг вызовов PriceService
+// Пока PriceService недоступен, запросы не ждут таймаута клиента, а сразу деградируют
+type Breaker struct {
+	name     string
+	cfg      BreakerConfig
+	reporter BreakerReporter
+	log      Logger
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probes   int
+	now      func() time.Time
+}
+
+// NewBreaker создает circuit breaker в закрытом состоянии
+// reporter может быть nil, если метрики отключены
+func NewBreaker(name string, cfg BreakerConfig, reporter BreakerReporter, log Logger) *Breaker {
+	if cfg.FailureThreshold < 1 {
+		cfg.FailureThreshold = 1
+	}
+	if cfg.HalfOpenMaxCalls < 1 {
+		cfg.HalfOpenMaxCalls = 1
+	}
+
+	b := &Breaker{
+		name:     name,
+		cfg:      cfg,
+		reporter: reporter,
+		log:      log,
+		state:    BreakerClosed,
+		now:      time.Now,
+	}
+	if reporter != nil {
+		reporter.SetCircuitBreakerState(name, int(BreakerClosed))
+	}
+
+	return b
+}
+
+// State возвращает текущее состояние breaker
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
+
+// allow проверяет, можно ли выполнить вызов
+// При успехе вызывающий обязан сообщить итог через done
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.cfg.CoolDown {
+			return ErrCircuitOpen
+		}
+		b.transition(BreakerHalfOpen)
+		fallthrough
+	case BreakerHalfOpen:
+		if b.probes >= b.cfg.HalfOpenMaxCalls {
+			return ErrCircuitOpen
+		}
+		b.probes++
+	}
+
+	return nil
+}
+
+// done учитывает итог вызова, разрешённого allow
+func (b *Breaker) done(result callResult) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == BreakerHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+
+	switch result {
+	case resultSuccess:
+		b.failures = 0
+		if b.state == BreakerHalfOpen {
+			b.transition(BreakerClosed)
+		}
+	case resultFailure:
+		switch b.state {
+		case BreakerHalfOpen:
+			b.open()
+		case BreakerClosed:
+			b.failures++
+			if b.failures >= b.cfg.FailureThreshold {
+				b.open()
+			}
+		}
+	}
+}
+
+// open переводит breaker в открытое состояние и запускает cool-down
+func (b *Breaker) open() {
+	b.openedAt = b.now()
+	b.transition(BreakerOpen)
+}
+
+// transition меняет состояние, сбрасывает счётчики и публикует метрики
+func (b *Breaker) transition(to BreakerState) {
+	from := b.state
+	if from == to {
+		return
+	}
+
+	b.state = to
+	b.failures = 0
+	b.probes = 0
+
+	if to == BreakerOpen {
+		b.log.Warn("Circuit breaker %s: %s -> %s, cool-down %s", b.name, from, to, b.cfg.CoolDown)
+	} else {
+		b.log.Info("Circuit breaker %s: %s -> %s", b.name, from, to)
+	}
+	if b.reporter != nil {
+		b.reporter.SetCircuitBreakerState(b.name, int(to))
+		b.reporter.RecordCircuitBreakerTransition(b.name, to.String())
+	}
+}
+
+// breakerResult определяет, говорит ли итог вызова о недоступности PriceService
+// Ответы 400 и 404 означают, что сервис работает; отмена вызывающим не учитывается
+func breakerResult(ctx context.Context, err error) callResult {
+	switch {
+	case err == nil, errors.Is(err, ErrPricesNotFound), errors.Is(err, errBadRequest):
+		return resultSuccess
+	case ctx.Err() != nil:
+		return resultIgnored
+	default:
+		return resultFailure
+	}
+}
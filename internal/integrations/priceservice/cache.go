@@ -0,0 +1,241 @@
+package priceservice
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// CacheConfig настройки кэша цен
+type CacheConfig struct {
+	TTL        time.Duration // Время жизни записи
+	MaxEntries int           // Максимум записей, при превышении вытесняются давно не использованные
+}
+
+// cacheKey ключ записи кэша: цена услуги для пользователя (userID=0 - анонимный запрос)
+// и явно переданного класса автомобиля (пустая строка - класс определяет PriceService)
+type cacheKey struct {
+	companyID    int64
+	userID       int64
+	vehicleClass string
+	serviceID    int64
+}
+
+// requestKey ключ кэша запроса без услуги
+func requestKey(req *CalculatePricesRequest) cacheKey {
+	key := cacheKey{companyID: req.CompanyID}
+	if req.UserID != nil {
+		key.userID = *req.UserID
+	}
+	if req.VehicleClass != nil {
+		key.vehicleClass = *req.VehicleClass
+	}
+	return key
+}
+
+// cacheEntry запись кэша
+// found=false запоминает, что PriceService не вернул цену для услуги
+type cacheEntry struct {
+	key       cacheKey
+	price     ServicePrice
+	found     bool
+	expiresAt time.Time
+}
+
+// CachedClient TTL/LRU кэш цен перед клиентом PriceService
+// Одновременные одинаковые запросы промахов объединяются в один вызов PriceService
+type CachedClient struct {
+	next Calculator
+	cfg  CacheConfig
+	log  Logger
+
+	mu          sync.Mutex
+	entries     map[cacheKey]*list.Element
+	lru         *list.List
+	byService   map[int64]map[cacheKey]struct{}
+	generations map[int64]uint64 // Увеличивается при инвалидации услуги, защищает от записи устаревших ответов
+
+	flights flightGroup
+	now     func() time.Time
+}
+
+// NewCachedClient создает кэш цен поверх next
+func NewCachedClient(next Calculator, cfg CacheConfig, log Logger) *CachedClient {
+	if cfg.MaxEntries < 1 {
+		cfg.MaxEntries = 1
+	}
+
+	return &CachedClient{
+		next:        next,
+		cfg:         cfg,
+		log:         log,
+		entries:     make(map[cacheKey]*list.Element),
+		lru:         list.New(),
+		byService:   make(map[int64]map[cacheKey]struct{}),
+		generations: make(map[int64]uint64),
+		now:         time.Now,
+	}
+}
+
+// CalculatePricesWithGracefulDegradation возвращает цены из кэша и запрашивает у PriceService только промахи
+// Если PriceService недоступен, возвращаются цены, найденные в кэше
+func (c *CachedClient) CalculatePricesWithGracefulDegradation(ctx context.Context, req *CalculatePricesRequest) (*CalculatePricesResponse, error) {
+	key := requestKey(req)
+
+	hits, misses := c.lookup(key, req.ServiceIDs)
+	if len(misses) == 0 {
+		return &CalculatePricesResponse{Prices: hits}, nil
+	}
+
+	generations := c.snapshotGenerations(misses)
+	missReq := &CalculatePricesRequest{
+		CompanyID:    req.CompanyID,
+		UserID:       req.UserID,
+		VehicleClass: req.VehicleClass,
+		ServiceIDs:   misses,
+	}
+
+	resp, err, shared := c.flights.do(flightKey(key, misses), func() (*CalculatePricesResponse, error) {
+		return c.next.CalculatePricesWithGracefulDegradation(ctx, missReq)
+	})
+	if err != nil {
+		if len(hits) > 0 {
+			c.log.Warn("Serving %d cached prices without misses for company_id=%d: %v", len(hits), req.CompanyID, err)
+			return &CalculatePricesResponse{Prices: hits, Degraded: true}, nil
+		}
+		return nil, err
+	}
+	if !shared {
+		c.store(key, misses, resp.Prices, resp.Degraded, generations)
+	}
+
+	return &CalculatePricesResponse{Prices: append(hits, resp.Prices...), Degraded: resp.Degraded}, nil
+}
+
+// InvalidateService удаляет из кэша цены услуги для всех пользователей
+func (c *CachedClient) InvalidateService(serviceID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[serviceID]++
+	for key := range c.byService[serviceID] {
+		if elem, ok := c.entries[key]; ok {
+			c.removeElement(elem)
+		}
+	}
+	delete(c.byService, serviceID)
+}
+
+// lookup возвращает найденные в кэше цены и ID услуг, которых в кэше нет
+// key - ключ запроса без услуги
+func (c *CachedClient) lookup(key cacheKey, serviceIDs []int64) ([]ServicePrice, []int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	hits := make([]ServicePrice, 0, len(serviceIDs))
+	misses := make([]int64, 0, len(serviceIDs))
+
+	for _, serviceID := range serviceIDs {
+		key.serviceID = serviceID
+		elem, ok := c.entries[key]
+		if !ok {
+			misses = append(misses, serviceID)
+			continue
+		}
+
+		entry := elem.Value.(*cacheEntry)
+		if now.After(entry.expiresAt) {
+			c.removeElement(elem)
+			misses = append(misses, serviceID)
+			continue
+		}
+
+		c.lru.MoveToFront(elem)
+		if entry.found {
+			hits = append(hits, entry.price)
+		}
+	}
+
+	return hits, misses
+}
+
+// snapshotGenerations запоминает поколения услуг перед запросом в PriceService
+func (c *CachedClient) snapshotGenerations(serviceIDs []int64) map[int64]uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	generations := make(map[int64]uint64, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		generations[serviceID] = c.generations[serviceID]
+	}
+
+	return generations
+}
+
+// store сохраняет ответ PriceService, включая услуги без цены
+// Услуги, инвалидированные во время запроса, не сохраняются.
+// В частичном ответе (degraded) отсутствие цены не запоминается: её могла потерять неудавшаяся часть запроса
+func (c *CachedClient) store(key cacheKey, serviceIDs []int64, prices []ServicePrice, degraded bool, generations map[int64]uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	priceMap := make(map[int64]ServicePrice, len(prices))
+	for _, price := range prices {
+		priceMap[price.ServiceID] = price
+	}
+
+	expiresAt := c.now().Add(c.cfg.TTL)
+	for _, serviceID := range serviceIDs {
+		if c.generations[serviceID] != generations[serviceID] {
+			continue
+		}
+
+		key.serviceID = serviceID
+		price, found := priceMap[serviceID]
+		if !found && degraded {
+			continue
+		}
+		entry := &cacheEntry{key: key, price: price, found: found, expiresAt: expiresAt}
+
+		if elem, ok := c.entries[key]; ok {
+			elem.Value = entry
+			c.lru.MoveToFront(elem)
+			continue
+		}
+
+		c.entries[key] = c.lru.PushFront(entry)
+		if c.byService[serviceID] == nil {
+			c.byService[serviceID] = make(map[cacheKey]struct{})
+		}
+		c.byService[serviceID][key] = struct{}{}
+
+		for c.lru.Len() > c.cfg.MaxEntries {
+			c.removeElement(c.lru.Back())
+		}
+	}
+}
+
+// removeElement удаляет запись из всех индексов, вызывается под c.mu
+func (c *CachedClient) removeElement(elem *list.Element) {
+	entry := elem.Value.(*cacheEntry)
+	c.lru.Remove(elem)
+	delete(c.entries, entry.key)
+
+	if keys, ok := c.byService[entry.key.serviceID]; ok {
+		delete(keys, entry.key)
+		if len(keys) == 0 {
+			delete(c.byService, entry.key.serviceID)
+		}
+	}
+}
+
+// flightKey ключ объединения одинаковых запросов
+func flightKey(key cacheKey, serviceIDs []int64) string {
+	ids := slices.Clone(serviceIDs)
+	slices.Sort(ids)
+	return fmt.Sprintf("%d:%d:%s:%v", key.companyID, key.userID, key.vehicleClass, ids)
+}
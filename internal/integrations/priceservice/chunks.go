@@ -0,0 +1,102 @@
+package priceservice
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ChunkConfig настройки разбиения запроса цен на части
+type ChunkConfig struct {
+	Size        int // Максимум услуг в одном запросе к PriceService
+	Concurrency int // Одновременных запросов частей
+}
+
+// chunkResult результат расчёта цен одной части запроса
+type chunkResult struct {
+	prices *CalculatePricesResponse
+	err    error
+}
+
+// splitServiceIDs разбивает ID услуг на части не больше size
+func splitServiceIDs(serviceIDs []int64, size int) [][]int64 {
+	chunks := make([][]int64, 0, (len(serviceIDs)+size-1)/size)
+	for start := 0; start < len(serviceIDs); start += size {
+		end := min(start+size, len(serviceIDs))
+		chunks = append(chunks, serviceIDs[start:end])
+	}
+	return chunks
+}
+
+// calculateChunks рассчитывает цены по частям параллельно, не более chunks.Concurrency одновременно
+// Успешные части объединяются; если часть не удалась, её услуги остаются без цен и ответ помечается Degraded.
+// Ошибка возвращается, только если не удалась ни одна часть
+func (c *Client) calculateChunks(ctx context.Context, req *CalculatePricesRequest) (*CalculatePricesResponse, error) {
+	chunks := splitServiceIDs(req.ServiceIDs, c.chunks.Size)
+	results := make([]chunkResult, len(chunks))
+	sem := make(chan struct{}, c.chunks.Concurrency)
+	var wg sync.WaitGroup
+
+	for i, serviceIDs := range chunks {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, serviceIDs []int64) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			chunkReq := &CalculatePricesRequest{
+				CompanyID:    req.CompanyID,
+				UserID:       req.UserID,
+				VehicleClass: req.VehicleClass,
+				ServiceIDs:   serviceIDs,
+			}
+			results[i].prices, results[i].err = c.calculateWithDegradation(ctx, chunkReq)
+		}(i, serviceIDs)
+	}
+
+	wg.Wait()
+	return c.mergeChunks(req.CompanyID, results)
+}
+
+// mergeChunks объединяет ответы частей
+// Часть без настроенных цен (ErrPricesNotFound) не считается деградацией
+func (c *Client) mergeChunks(companyID int64, results []chunkResult) (*CalculatePricesResponse, error) {
+	merged := &CalculatePricesResponse{}
+	var firstErr error
+	succeeded, failed := 0, 0
+
+	for _, result := range results {
+		switch {
+		case result.err == nil:
+			merged.Prices = append(merged.Prices, result.prices.Prices...)
+			merged.Degraded = merged.Degraded || result.prices.Degraded
+			succeeded++
+		case errors.Is(result.err, ErrPricesNotFound):
+			succeeded++
+		default:
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			failed++
+		}
+	}
+
+	switch {
+	case failed == len(results):
+		return nil, firstErr
+	case len(merged.Prices) == 0 && failed == 0:
+		return nil, ErrPricesNotFound
+	case failed > 0:
+		c.log.Warn("PriceService failed %d of %d price chunks for company_id=%d, serving partial prices: %v", failed, len(results), companyID, firstErr)
+		merged.Degraded = true
+	}
+
+	c.log.Info("Merged %d price chunks for company_id=%d, count=%d (succeeded=%d)", len(results), companyID, len(merged.Prices), succeeded)
+	return merged, nil
+}
@@ -4,63 +4,158 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
 )
 
+// HTTPConfig настройки HTTP клиента PriceService
+type HTTPConfig struct {
+	Timeout         time.Duration // Таймаут одной попытки запроса
+	MaxIdleConns    int           // Максимум idle соединений с PriceService
+	MaxConnsPerHost int           // Максимум соединений с PriceService, 0 - без ограничения
+	IdleConnTimeout time.Duration // Время жизни idle соединения
+}
+
+// newHTTPClient создает HTTP клиент с отдельным пулом соединений к PriceService
+func newHTTPClient(cfg HTTPConfig) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = cfg.MaxIdleConns
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConns
+	transport.MaxConnsPerHost = cfg.MaxConnsPerHost
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+
+	return &http.Client{
+		Timeout:   cfg.Timeout,
+		Transport: transport,
+	}
+}
+
 // Client клиент для работы с PriceService
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
-	log        Logger
+	endpoints   *endpointPool
+	httpClient  *http.Client
+	retry       RetryPolicy
+	chunks      ChunkConfig
+	credentials CredentialsConfig
+	breaker     *Breaker
+	log         Logger
+	now         func() time.Time
 }
 
 // NewClient создает новый экземпляр клиента PriceService
-func NewClient(baseURL string, log Logger) *Client {
+func NewClient(endpoints EndpointConfig, httpCfg HTTPConfig, retry RetryPolicy, chunks ChunkConfig, credentials CredentialsConfig, breaker *Breaker, log Logger) *Client {
+	if retry.MaxAttempts < 1 {
+		retry.MaxAttempts = 1
+	}
+	if chunks.Size < 1 {
+		chunks.Size = 1
+	}
+	if chunks.Concurrency < 1 {
+		chunks.Concurrency = 1
+	}
+
 	return &Client{
-		baseURL: baseURL,
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
-		log: log,
+		endpoints:   newEndpointPool(endpoints, log),
+		httpClient:  newHTTPClient(httpCfg),
+		retry:       retry,
+		chunks:      chunks,
+		credentials: credentials,
+		breaker:     breaker,
+		log:         log,
+		now:         time.Now,
 	}
 }
 
 // CalculatePrices вызывает endpoint /api/v1/prices/calculate для расчёта цен
+// Временные ошибки (сеть, таймаут, 502/503/504) повторяются согласно RetryPolicy
+// в пределах дедлайна контекста вызывающего.
+// Пока circuit breaker открыт, запрос не выполняется и возвращается ErrCircuitOpen
 func (c *Client) CalculatePrices(ctx context.Context, req *CalculatePricesRequest) (*CalculatePricesResponse, error) {
-	url := fmt.Sprintf("%s/api/v1/prices/calculate", c.baseURL)
-
 	// Маршалим тело запроса
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to marshal request: %v", ErrInternal, err)
 	}
 
+	if err := c.breaker.allow(); err != nil {
+		return nil, err
+	}
+
+	prices, err := c.calculatePricesWithRetry(ctx, req, body)
+	c.breaker.done(breakerResult(ctx, err))
+
+	return prices, err
+}
+
+// calculatePricesWithRetry выполняет расчёт цен с повторами временных ошибок
+// Повтор по возможности отправляется на другой endpoint, чем предыдущая попытка
+func (c *Client) calculatePricesWithRetry(ctx context.Context, req *CalculatePricesRequest, body []byte) (*CalculatePricesResponse, error) {
+	var prev *endpoint
+	for attempt := 1; ; attempt++ {
+		result := c.attempt(ctx, body, prev)
+		prices, err := result.prices, result.err
+		if err == nil {
+			return prices, nil
+		}
+		prev = result.endpoint
+
+		var retryErr *retryableError
+		if !errors.As(err, &retryErr) || attempt >= c.retry.MaxAttempts {
+			return nil, err
+		}
+
+		delay, ok := c.retry.nextDelay(ctx, attempt, retryErr.retryAfter)
+		if !ok {
+			return nil, err
+		}
+
+		c.log.Warn("PriceService attempt %d/%d failed for company_id=%d on %s, retrying in %s: %v", attempt, c.retry.MaxAttempts, req.CompanyID, prev.url, delay, err)
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
+
+// calculatePricesOnce выполняет одну попытку расчёта цен на endpoint baseURL
+func (c *Client) calculatePricesOnce(ctx context.Context, baseURL string, body []byte) (*CalculatePricesResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/prices/calculate", baseURL)
+
 	// Создаём HTTP запрос
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
 	if err != nil {
 		return nil, fmt.Errorf("%w: failed to create request: %v", ErrInternal, err)
 	}
 
-	httpReq.Header.Set("Content-Type", "application/json")
+	c.setRequestHeaders(ctx, httpReq, body)
 
 	// Выполняем запрос
 	resp, err := c.httpClient.Do(httpReq)
 	if err != nil {
-		return nil, fmt.Errorf("%w: failed to execute request: %v", ErrInternal, err)
+		wrapped := fmt.Errorf("%w: failed to execute request: %v", ErrInternal, err)
+		if isRetryableTransportError(ctx, err) {
+			return nil, &retryableError{err: wrapped}
+		}
+		return nil, wrapped
 	}
 	defer resp.Body.Close()
 
 	// Обработка статус-кодов
-	switch resp.StatusCode {
-	case http.StatusOK:
+	switch {
+	case resp.StatusCode == http.StatusOK:
 		// Продолжаем обработку
-	case http.StatusBadRequest:
-		return nil, fmt.Errorf("%w: bad request", ErrInvalidResponse)
-	case http.StatusNotFound:
+	case resp.StatusCode == http.StatusBadRequest:
+		return nil, fmt.Errorf("%w: %w", ErrInvalidResponse, errBadRequest)
+	case resp.StatusCode == http.StatusNotFound:
 		return nil, ErrPricesNotFound
+	case isRetryableStatus(resp.StatusCode):
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &retryableError{
+			err:        fmt.Errorf("%w: unexpected status code %d: %s", ErrInvalidResponse, resp.StatusCode, string(body)),
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()),
+		}
 	default:
 		body, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("%w: unexpected status code %d: %s", ErrInvalidResponse, resp.StatusCode, string(body))
@@ -76,8 +171,18 @@ func (c *Client) CalculatePrices(ctx context.Context, req *CalculatePricesReques
 }
 
 // CalculatePricesWithGracefulDegradation вызывает расчёт цен с graceful degradation
-// При недоступности PriceService возвращает ErrServiceDegraded, что позволяет сервису вернуть данные без цен
+// При недоступности PriceService возвращает ErrServiceDegraded, что позволяет сервису вернуть данные без цен.
+// Запрос с числом услуг больше ChunkConfig.Size разбивается на части, которые выполняются параллельно;
+// ошибка части лишает цен только её услуги, а ответ помечается Degraded
 func (c *Client) CalculatePricesWithGracefulDegradation(ctx context.Context, req *CalculatePricesRequest) (*CalculatePricesResponse, error) {
+	if len(req.ServiceIDs) > c.chunks.Size {
+		return c.calculateChunks(ctx, req)
+	}
+	return c.calculateWithDegradation(ctx, req)
+}
+
+// calculateWithDegradation рассчитывает цены одним запросом с graceful degradation
+func (c *Client) calculateWithDegradation(ctx context.Context, req *CalculatePricesRequest) (*CalculatePricesResponse, error) {
 	if req.UserID != nil {
 		c.log.Info("Calculating prices for company_id=%d, user_id=%d, services=%v", req.CompanyID, *req.UserID, req.ServiceIDs)
 	} else {
@@ -93,6 +198,12 @@ func (c *Client) CalculatePricesWithGracefulDegradation(ctx context.Context, req
 			return nil, err
 		}
 
+		// Breaker открыт: PriceService уже признан недоступным, не засоряем лог ошибками
+		if errors.Is(err, ErrCircuitOpen) {
+			c.log.Warn("PriceService circuit breaker is open, skipping price enrichment for company_id=%d", req.CompanyID)
+			return nil, fmt.Errorf("%w: company_id=%d, error=%v", ErrServiceDegraded, req.CompanyID, err)
+		}
+
 		// Для всех остальных ошибок (недоступность сервиса, timeout, ошибки парсинга и т.д.)
 		// применяем graceful degradation - возвращаем ErrServiceDegraded с контекстом
 		// Повышаем уровень логирования до ERROR, чтобы быстрее заметить проблему
@@ -0,0 +1,145 @@
+package priceservice
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// PricingTypeFallbackBase тип цены, подставленной из базовой цены услуги вместо ответа PriceService
+const PricingTypeFallbackBase = "fallback_base"
+
+// CompositeClient источник цен: сначала PriceService, затем базовые цены услуг из БД
+// Базовая цена подставляется, если PriceService недоступен, не уложился в бюджет или не знает цену услуги
+type CompositeClient struct {
+	remote Calculator
+	source BasePriceSource
+	budget time.Duration // Сколько ждать PriceService, 0 - без ограничения
+	log    Logger
+
+	backgroundTimeout time.Duration // Предел запроса, продолжающегося в фоне после budget
+	batchConcurrency  int           // Одновременных запросов в CalculatePricesBatch
+}
+
+// NewCompositeClient создает источник цен с запасными базовыми ценами
+func NewCompositeClient(remote Calculator, source BasePriceSource, budget time.Duration, backgroundTimeout time.Duration, batchConcurrency int, log Logger) *CompositeClient {
+	if batchConcurrency < 1 {
+		batchConcurrency = 1
+	}
+
+	return &CompositeClient{
+		remote: remote,
+		source: source,
+		budget: budget,
+		log:    log,
+
+		backgroundTimeout: backgroundTimeout,
+		batchConcurrency:  batchConcurrency,
+	}
+}
+
+// CalculatePricesWithGracefulDegradation возвращает цены PriceService, дополненные базовыми ценами
+// Если PriceService недоступен, ответ помечается Degraded; ошибка возвращается, только если подставить нечего
+func (c *CompositeClient) CalculatePricesWithGracefulDegradation(ctx context.Context, req *CalculatePricesRequest) (*CalculatePricesResponse, error) {
+	resp, err := c.calculateRemote(ctx, req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+
+		fallback := c.fallbackPrices(ctx, req.CompanyID, req.ServiceIDs)
+		if len(fallback) == 0 {
+			return nil, err
+		}
+
+		// ErrPricesNotFound - PriceService работает, просто не знает цены этих услуг
+		degraded := !errors.Is(err, ErrPricesNotFound)
+		c.log.Info("Using %d base prices for company_id=%d (degraded=%t)", len(fallback), req.CompanyID, degraded)
+		return &CalculatePricesResponse{Prices: fallback, Degraded: degraded}, nil
+	}
+
+	// Услуги, для которых PriceService не вернул цену
+	priced := make(map[int64]struct{}, len(resp.Prices))
+	for _, price := range resp.Prices {
+		priced[price.ServiceID] = struct{}{}
+	}
+	missing := make([]int64, 0, len(req.ServiceIDs))
+	for _, serviceID := range req.ServiceIDs {
+		if _, ok := priced[serviceID]; !ok {
+			missing = append(missing, serviceID)
+		}
+	}
+	if len(missing) == 0 {
+		return resp, nil
+	}
+
+	fallback := c.fallbackPrices(ctx, req.CompanyID, missing)
+	if len(fallback) == 0 {
+		return resp, nil
+	}
+
+	prices := make([]ServicePrice, 0, len(resp.Prices)+len(fallback))
+	prices = append(prices, resp.Prices...)
+	prices = append(prices, fallback...)
+	return &CalculatePricesResponse{Prices: prices, Degraded: resp.Degraded}, nil
+}
+
+// calculateRemote запрашивает цены у PriceService, ожидая ответ не дольше budget
+// Запрос выполняется независимо от контекста вызывающего: после истечения бюджета
+// он продолжается в фоне не дольше backgroundTimeout и его результат попадает в кэш цен для следующих запросов
+func (c *CompositeClient) calculateRemote(ctx context.Context, req *CalculatePricesRequest) (*CalculatePricesResponse, error) {
+	if c.budget <= 0 {
+		return c.remote.CalculatePricesWithGracefulDegradation(ctx, req)
+	}
+
+	type result struct {
+		resp *CalculatePricesResponse
+		err  error
+	}
+	done := make(chan result, 1)
+	// Отмена вызывающего не прерывает запрос, но дедлайн ограничивает повторы и время жизни горутины
+	remoteCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.backgroundTimeout)
+	go func() {
+		defer cancel()
+		resp, err := c.remote.CalculatePricesWithGracefulDegradation(remoteCtx, req)
+		done <- result{resp: resp, err: err}
+	}()
+
+	timer := time.NewTimer(c.budget)
+	defer timer.Stop()
+
+	select {
+	case res := <-done:
+		return res.resp, res.err
+	case <-timer.C:
+		c.log.Warn("PriceService did not respond within %s budget for company_id=%d", c.budget, req.CompanyID)
+		return nil, ErrBudgetExceeded
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fallbackPrices возвращает базовые цены услуг в формате PriceService
+// Ошибка чтения БД не прерывает запрос: каталог просто останется без запасных цен
+func (c *CompositeClient) fallbackPrices(ctx context.Context, companyID int64, serviceIDs []int64) []ServicePrice {
+	basePrices, err := c.source.ListBasePrices(ctx, companyID, serviceIDs)
+	if err != nil {
+		c.log.Error("Failed to load base prices for company_id=%d: %v", companyID, err)
+		return nil
+	}
+
+	pricingType := PricingTypeFallbackBase
+	prices := make([]ServicePrice, 0, len(basePrices))
+	for _, base := range basePrices {
+		price := base.Price
+		currency := base.Currency
+		prices = append(prices, ServicePrice{
+			ServiceID:   base.ServiceID,
+			Price:       &price,
+			Currency:    &currency,
+			PricingType: &pricingType,
+		})
+	}
+
+	return prices
+}
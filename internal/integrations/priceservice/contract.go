@@ -1,8 +1,30 @@
 package priceservice
 
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+)
+
 // Logger интерфейс для логирования
 type Logger interface {
 	Info(format string, v ...interface{})
 	Warn(format string, v ...interface{})
 	Error(format string, v ...interface{})
 }
+
+// BreakerReporter публикует состояние circuit breaker (реализуется pkg/metrics)
+type BreakerReporter interface {
+	SetCircuitBreakerState(breaker string, state int)
+	RecordCircuitBreakerTransition(breaker string, state string)
+}
+
+// Calculator источник цен, перед которым работает CachedClient
+type Calculator interface {
+	CalculatePricesWithGracefulDegradation(ctx context.Context, req *CalculatePricesRequest) (*CalculatePricesResponse, error)
+}
+
+// BasePriceSource источник базовых цен услуг (реализуется репозиторием услуг)
+type BasePriceSource interface {
+	ListBasePrices(ctx context.Context, companyID int64, serviceIDs []int64) ([]domain.ServiceBasePrice, error)
+}
@@ -0,0 +1,72 @@
+package priceservice
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+
+	"github.com/m04kA/SMK-SellerService/internal/requestctx"
+)
+
+// Режимы аутентификации SellerService в PriceService
+const (
+	AuthModeNone   = "none"   // Без учётных данных
+	AuthModeBearer = "bearer" // Статический токен в Authorization: Bearer
+	AuthModeHMAC   = "hmac"   // Подпись запроса общим секретом
+)
+
+// Заголовки запросов к PriceService
+const (
+	headerRequestID = "X-Request-ID"
+	headerUserRole  = "X-User-Role"
+	headerCallerID  = "X-Caller-ID"
+	headerTimestamp = "X-Timestamp"
+	headerSignature = "X-Signature"
+)
+
+// CredentialsConfig учётные данные SellerService для PriceService
+type CredentialsConfig struct {
+	Mode       string // AuthModeNone, AuthModeBearer или AuthModeHMAC
+	CallerID   string // Имя вызывающего сервиса в X-Caller-ID
+	Token      string // Токен для AuthModeBearer
+	HMACSecret string // Секрет для AuthModeHMAC
+}
+
+// setRequestHeaders добавляет к запросу идентичность вызывающего и учётные данные
+// ID запроса и роль пользователя берутся из контекста входящего запроса
+func (c *Client) setRequestHeaders(ctx context.Context, req *http.Request, body []byte) {
+	req.Header.Set("Content-Type", "application/json")
+
+	if requestID, ok := requestctx.CorrelationID(ctx); ok {
+		req.Header.Set(headerRequestID, requestID)
+	}
+	if userRole, ok := requestctx.UserRole(ctx); ok {
+		req.Header.Set(headerUserRole, userRole)
+	}
+	if c.credentials.CallerID != "" {
+		req.Header.Set(headerCallerID, c.credentials.CallerID)
+	}
+
+	switch c.credentials.Mode {
+	case AuthModeBearer:
+		req.Header.Set("Authorization", "Bearer "+c.credentials.Token)
+	case AuthModeHMAC:
+		timestamp := strconv.FormatInt(c.now().Unix(), 10)
+		req.Header.Set(headerTimestamp, timestamp)
+		req.Header.Set(headerSignature, signRequest(c.credentials.HMACSecret, req.Method, req.URL.Path, timestamp, body))
+	}
+}
+
+// signRequest подписывает запрос: HMAC-SHA256 от строки
+// "METHOD\nPATH\nTIMESTAMP\nhex(SHA256(body))" в hex
+// Timestamp в подписи позволяет PriceService отклонять повторно отправленные запросы
+func signRequest(secret, method, path, timestamp string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(method + "\n" + path + "\n" + timestamp + "\n" + hex.EncodeToString(bodyHash[:])))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,135 @@
+package priceservice
+
+import (
+	"sync"
+	"time"
+)
+
+// Стратегии выбора endpoint PriceService
+const (
+	BalancingRoundRobin  = "round_robin"  // По очереди
+	BalancingLeastLoaded = "least_loaded" // Endpoint с наименьшим числом запросов в работе
+)
+
+// EndpointConfig настройки endpoints PriceService
+type EndpointConfig struct {
+	URLs               []string      // Базовые URL экземпляров PriceService (например, по зонам)
+	Balancing          string        // BalancingRoundRobin или BalancingLeastLoaded
+	EjectAfterFailures int           // Ошибок подряд, после которых endpoint исключается из выбора
+	EjectDuration      time.Duration // Время исключения endpoint
+	HedgeDelay         time.Duration // Через сколько дублировать запрос на другой endpoint, 0 - без дублирования
+}
+
+// endpoint экземпляр PriceService и его пассивное состояние здоровья
+type endpoint struct {
+	url          string
+	inflight     int       // Запросов в работе
+	failures     int       // Ошибок подряд
+	ejectedUntil time.Time // Нулевое значение - endpoint не исключался
+}
+
+// endpointPool выбирает endpoint для запроса и отслеживает здоровье по итогам запросов
+// Endpoint, ответивший ошибкой EjectAfterFailures раз подряд, исключается на EjectDuration;
+// после этого он снова получает запросы, и первый успешный возвращает его в работу
+type endpointPool struct {
+	cfg EndpointConfig
+	log Logger
+
+	mu        sync.Mutex
+	endpoints []*endpoint
+	next      int // Позиция, с которой начинается следующий выбор
+	now       func() time.Time
+}
+
+// newEndpointPool создает пул endpoints
+func newEndpointPool(cfg EndpointConfig, log Logger) *endpointPool {
+	if cfg.EjectAfterFailures < 1 {
+		cfg.EjectAfterFailures = 1
+	}
+
+	endpoints := make([]*endpoint, len(cfg.URLs))
+	for i, url := range cfg.URLs {
+		endpoints[i] = &endpoint{url: url}
+	}
+
+	return &endpointPool{
+		cfg:       cfg,
+		log:       log,
+		endpoints: endpoints,
+		now:       time.Now,
+	}
+}
+
+// multiple сообщает, есть ли куда переключиться или продублировать запрос
+func (p *endpointPool) multiple() bool {
+	return len(p.endpoints) > 1
+}
+
+// acquire выбирает endpoint для запроса, по возможности отличный от exclude
+// Исключённые endpoints выбираются, только если других нет: лучше попробовать, чем сразу деградировать.
+// Вызывающий обязан сообщить итог запроса через release
+func (p *endpointPool) acquire(exclude *endpoint) *endpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	ep := p.pick(func(ep *endpoint) bool { return ep != exclude && !now.Before(ep.ejectedUntil) })
+	if ep == nil {
+		ep = p.pick(func(ep *endpoint) bool { return ep != exclude })
+	}
+	if ep == nil {
+		ep = exclude
+	}
+
+	ep.inflight++
+	return ep
+}
+
+// pick выбирает подходящий endpoint согласно стратегии, вызывается под p.mu
+func (p *endpointPool) pick(eligible func(*endpoint) bool) *endpoint {
+	var chosen *endpoint
+	chosenIdx := 0
+
+	for i := range p.endpoints {
+		idx := (p.next + i) % len(p.endpoints)
+		ep := p.endpoints[idx]
+		if !eligible(ep) {
+			continue
+		}
+		if chosen == nil || (p.cfg.Balancing == BalancingLeastLoaded && ep.inflight < chosen.inflight) {
+			chosen, chosenIdx = ep, idx
+		}
+		if p.cfg.Balancing != BalancingLeastLoaded {
+			break
+		}
+	}
+
+	if chosen != nil {
+		p.next = chosenIdx + 1
+	}
+	return chosen
+}
+
+// release учитывает итог запроса к endpoint
+func (p *endpointPool) release(ep *endpoint, result callResult) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ep.inflight--
+
+	switch result {
+	case resultSuccess:
+		if !ep.ejectedUntil.IsZero() {
+			p.log.Info("PriceService endpoint %s is healthy again", ep.url)
+			ep.ejectedUntil = time.Time{}
+		}
+		ep.failures = 0
+	case resultFailure:
+		ep.failures++
+		now := p.now()
+		if ep.failures >= p.cfg.EjectAfterFailures && !now.Before(ep.ejectedUntil) {
+			ep.ejectedUntil = now.Add(p.cfg.EjectDuration)
+			p.log.Warn("PriceService endpoint %s ejected for %s after %d consecutive failures", ep.url, p.cfg.EjectDuration, ep.failures)
+		}
+	}
+}
@@ -12,7 +12,20 @@ var (
 	// ErrInvalidResponse возвращается при некорректном ответе от сервиса
 	ErrInvalidResponse = errors.New("priceservice client: invalid response")
 
+	// ErrCircuitOpen возвращается, когда circuit breaker открыт и запрос не выполнялся
+	ErrCircuitOpen = errors.New("priceservice client: circuit breaker is open")
+
+	// ErrBudgetExceeded возвращается, когда PriceService не ответил в пределах бюджета обогащения
+	ErrBudgetExceeded = errors.New("priceservice client: enrichment budget exceeded")
+
 	// ErrServiceDegraded возвращается при применении graceful degradation
 	// Указывает, что PriceService недоступен и следует вернуть данные без цен
 	ErrServiceDegraded = errors.New("priceservice unavailable: graceful degradation applied")
 )
+
+// errBadRequest отмечает ответ 400: PriceService доступен, ошибка в запросе
+var errBadRequest = errors.New("bad request")
+
+// ErrEventRejected возвращается, когда PriceService отклонил событие услуги (400)
+// Повтор такого события бессмыслен
+var ErrEventRejected = errors.New("priceservice client: service event rejected")
@@ -0,0 +1,55 @@
+package priceservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// NotifyServiceEvent вызывает endpoint /api/v1/service-events, сообщая PriceService об изменении услуги
+// Выполняется одна попытка без circuit breaker: повторы с backoff делает вызывающий (outbox).
+// Ответ 400 возвращается как ErrEventRejected, остальные ошибки временные
+func (c *Client) NotifyServiceEvent(ctx context.Context, event *ServiceEventRequest) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("%w: failed to marshal request: %v", ErrInternal, err)
+	}
+
+	ep := c.endpoints.acquire(nil)
+	err = c.notifyServiceEventOnce(ctx, ep.url, body)
+	c.endpoints.release(ep, breakerResult(ctx, err))
+
+	return err
+}
+
+// notifyServiceEventOnce отправляет событие на endpoint baseURL
+func (c *Client) notifyServiceEventOnce(ctx context.Context, baseURL string, body []byte) error {
+	url := fmt.Sprintf("%s/api/v1/service-events", baseURL)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: failed to create request: %v", ErrInternal, err)
+	}
+
+	c.setRequestHeaders(ctx, httpReq, body)
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return fmt.Errorf("%w: failed to execute request: %v", ErrInternal, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode == http.StatusBadRequest:
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%w: %w: %s", ErrEventRejected, errBadRequest, string(body))
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%w: unexpected status code %d: %s", ErrInvalidResponse, resp.StatusCode, string(body))
+	}
+}
@@ -0,0 +1,69 @@
+package priceservice
+
+import (
+	"context"
+	"time"
+)
+
+// attemptResult итог попытки на одном endpoint
+type attemptResult struct {
+	prices   *CalculatePricesResponse
+	endpoint *endpoint
+	err      error
+}
+
+// attempt выполняет одну попытку расчёта цен на endpoint, по возможности отличном от prev
+// Если задан HedgeDelay и endpoints несколько, попытка дублируется на другом endpoint
+func (c *Client) attempt(ctx context.Context, body []byte, prev *endpoint) attemptResult {
+	primary := c.endpoints.acquire(prev)
+	if c.endpoints.cfg.HedgeDelay <= 0 || !c.endpoints.multiple() {
+		prices, err := c.calculatePricesOnce(ctx, primary.url, body)
+		c.endpoints.release(primary, breakerResult(ctx, err))
+		return attemptResult{prices: prices, endpoint: primary, err: err}
+	}
+
+	return c.hedgedAttempt(ctx, body, primary)
+}
+
+// hedgedAttempt отправляет запрос на primary и, если ответа нет дольше HedgeDelay, дублирует его на другой endpoint
+// Побеждает первый окончательный ответ (цены, 400 или 404), второй запрос отменяется.
+// Ошибка primary до дублирования возвращается сразу: переключение выполнит повтор
+func (c *Client) hedgedAttempt(ctx context.Context, body []byte, primary *endpoint) attemptResult {
+	hedgeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Буфер на оба запроса: проигравший завершится без ожидания читателя
+	results := make(chan attemptResult, 2)
+	run := func(ep *endpoint) {
+		go func() {
+			prices, err := c.calculatePricesOnce(hedgeCtx, ep.url, body)
+			c.endpoints.release(ep, breakerResult(hedgeCtx, err))
+			results <- attemptResult{prices: prices, endpoint: ep, err: err}
+		}()
+	}
+
+	run(primary)
+	pending := 1
+
+	timer := time.NewTimer(c.endpoints.cfg.HedgeDelay)
+	defer timer.Stop()
+	hedgeC := timer.C
+
+	for {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			hedge := c.endpoints.acquire(primary)
+			c.log.Info("PriceService endpoint %s is slow, hedging request to %s", primary.url, hedge.url)
+			run(hedge)
+			pending++
+		case result := <-results:
+			pending--
+			if breakerResult(ctx, result.err) == resultSuccess || hedgeC != nil || pending == 0 {
+				return result
+			}
+			// Второй запрос ещё выполняется - ждём его
+			c.log.Warn("PriceService endpoint %s failed, waiting for hedged request: %v", result.endpoint.url, result.err)
+		}
+	}
+}
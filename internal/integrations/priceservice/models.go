@@ -1,15 +1,21 @@
 package priceservice
 
+import "time"
+
 // CalculatePricesRequest запрос на расчёт цен
 type CalculatePricesRequest struct {
-	CompanyID  int64   `json:"company_id"`
-	UserID     *int64  `json:"user_id,omitempty"`
-	ServiceIDs []int64 `json:"service_ids"`
+	CompanyID int64  `json:"company_id"`
+	UserID    *int64 `json:"user_id,omitempty"`
+	// VehicleClass явный класс автомобиля, приоритетнее автомобиля пользователя
+	VehicleClass *string `json:"vehicle_class,omitempty"`
+	ServiceIDs   []int64 `json:"service_ids"`
 }
 
 // CalculatePricesResponse ответ с рассчитанными ценами
 type CalculatePricesResponse struct {
 	Prices []ServicePrice `json:"prices"`
+	// Degraded - PriceService недоступен, цены взяты из кэша или базовых цен услуг
+	Degraded bool `json:"-"`
 }
 
 // ServicePrice цена на услугу
@@ -26,3 +32,20 @@ type ServicePrice struct {
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
+
+// Типы событий жизненного цикла услуги
+const (
+	ServiceEventCreated = "created"
+	ServiceEventUpdated = "updated"
+	ServiceEventDeleted = "deleted"
+)
+
+// ServiceEventRequest уведомление о создании, изменении или удалении услуги
+type ServiceEventRequest struct {
+	// EventID идентификатор события, PriceService по нему отбрасывает повторы
+	EventID    int64     `json:"event_id"`
+	Type       string    `json:"type"`
+	CompanyID  int64     `json:"company_id"`
+	ServiceID  int64     `json:"service_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
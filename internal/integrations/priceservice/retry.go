@@ -0,0 +1,129 @@
+package priceservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy настройки повторов запросов к PriceService
+// Расчёт цен не меняет состояние PriceService, поэтому запрос безопасно повторять
+type RetryPolicy struct {
+	MaxAttempts int           // Всего попыток, включая первую; 1 - без повторов
+	BaseDelay   time.Duration // Задержка перед первым повтором
+	MaxDelay    time.Duration // Потолок задержки между попытками
+}
+
+// retryableError временная ошибка, после которой запрос можно повторить
+type retryableError struct {
+	err        error
+	retryAfter time.Duration // Задержка из заголовка Retry-After, 0 - не задана
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+// backoff возвращает задержку перед повтором номер attempt (начиная с 1)
+// Экспоненциальный рост от BaseDelay до MaxDelay со случайным разбросом в [d/2, d],
+// чтобы клиенты не повторяли запросы синхронно после рестарта PriceService
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int64N(int64(delay-half)+1))
+}
+
+// nextDelay выбирает задержку перед следующей попыткой
+// Возвращает false, если повторять не имеет смысла: Retry-After просит ждать дольше MaxDelay
+// или ожидание не укладывается в дедлайн вызывающего
+func (p RetryPolicy) nextDelay(ctx context.Context, attempt int, retryAfter time.Duration) (time.Duration, bool) {
+	delay := p.backoff(attempt)
+	if retryAfter > 0 {
+		if retryAfter > p.MaxDelay {
+			return 0, false
+		}
+		delay = retryAfter
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+		return 0, false
+	}
+
+	return delay, true
+}
+
+// isRetryableStatus проверяет, что статус означает временную недоступность PriceService
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableTransportError проверяет, что сетевая ошибка временная:
+// соединение отклонено или сброшено (рестарт PriceService) либо истёк таймаут попытки.
+// Отмена или дедлайн контекста вызывающего не повторяются
+func isRetryableTransportError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// parseRetryAfter разбирает заголовок Retry-After в секундах или в формате HTTP-даты
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// sleep ждёт delay или отмены контекста
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
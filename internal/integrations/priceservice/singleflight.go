@@ -0,0 +1,44 @@
+package priceservice
+
+import "sync"
+
+// flightCall выполняющийся запрос, результат которого ждут несколько вызывающих
+type flightCall struct {
+	wg   sync.WaitGroup
+	resp *CalculatePricesResponse
+	err  error
+}
+
+// flightGroup объединяет одновременные одинаковые запросы в один вызов PriceService
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+// do выполняет fn один раз для всех одновременных вызовов с одинаковым key
+// shared=true означает, что результат получен чужим вызовом
+func (g *flightGroup) do(key string, fn func() (*CalculatePricesResponse, error)) (resp *CalculatePricesResponse, err error, shared bool) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall)
+	}
+	if call, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		call.wg.Wait()
+		return call.resp, call.err, true
+	}
+
+	call := &flightCall{}
+	call.wg.Add(1)
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	call.resp, call.err = fn()
+	call.wg.Done()
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	return call.resp, call.err, false
+}
@@ -0,0 +1,151 @@
+// Package stub содержит локальную замену PriceService для разработки и тестов
+// Сервер реализует расчёт цен и приём событий услуг по контракту schemas/smc-priceservice.yaml,
+// хранит всё в памяти и позволяет имитировать недоступность PriceService
+package stub
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice"
+)
+
+// Server in-memory реализация PriceService
+// Используется через httptest.NewServer в тестах или через cmd/priceservice-stub локально
+type Server struct {
+	defaultPrice float64
+	currency     string
+
+	mu          sync.Mutex
+	prices      map[int64]float64 // Цены, заданные для отдельных услуг
+	deleted     map[int64]bool    // Услуги, об удалении которых сообщил SellerService
+	events      []priceservice.ServiceEventRequest
+	seenEvents  map[int64]bool // Идентификаторы принятых событий, повторы не сохраняются
+	forceStatus int            // Если не 0, все запросы завершаются этим статусом
+}
+
+// NewServer создает сервер, возвращающий defaultPrice для услуг без заданной цены
+func NewServer(defaultPrice float64, currency string) *Server {
+	return &Server{
+		defaultPrice: defaultPrice,
+		currency:     currency,
+		prices:       make(map[int64]float64),
+		deleted:      make(map[int64]bool),
+		seenEvents:   make(map[int64]bool),
+	}
+}
+
+// SetPrice задаёт цену услуги
+func (s *Server) SetPrice(serviceID int64, price float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prices[serviceID] = price
+}
+
+// SetStatus заставляет сервер отвечать на все запросы статусом code (например, 503), 0 - штатная работа
+func (s *Server) SetStatus(code int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.forceStatus = code
+}
+
+// Events возвращает принятые события услуг в порядке получения
+func (s *Server) Events() []priceservice.ServiceEventRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]priceservice.ServiceEventRequest(nil), s.events...)
+}
+
+// ServeHTTP обрабатывает запросы к API PriceService
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	status := s.forceStatus
+	s.mu.Unlock()
+	if status != 0 {
+		writeError(w, status, "forced failure")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/prices/calculate":
+		s.calculatePrices(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/service-events":
+		s.serviceEvent(w, r)
+	default:
+		writeError(w, http.StatusNotFound, "not found")
+	}
+}
+
+// calculatePrices возвращает цены запрошенных услуг, удалённые услуги пропускаются
+func (s *Server) calculatePrices(w http.ResponseWriter, r *http.Request) {
+	var req priceservice.CalculatePricesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.ServiceIDs) == 0 {
+		writeError(w, http.StatusBadRequest, "invalid request")
+		return
+	}
+
+	s.mu.Lock()
+	resp := priceservice.CalculatePricesResponse{Prices: make([]priceservice.ServicePrice, 0, len(req.ServiceIDs))}
+	for _, id := range req.ServiceIDs {
+		if s.deleted[id] {
+			continue
+		}
+		price, ok := s.prices[id]
+		if !ok {
+			price = s.defaultPrice
+		}
+		currency := s.currency
+		pricingType := "static"
+		resp.Prices = append(resp.Prices, priceservice.ServicePrice{
+			ServiceID:    id,
+			Price:        &price,
+			Currency:     &currency,
+			PricingType:  &pricingType,
+			VehicleClass: req.VehicleClass,
+		})
+	}
+	s.mu.Unlock()
+
+	if len(resp.Prices) == 0 {
+		writeError(w, http.StatusNotFound, "prices not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// serviceEvent принимает событие жизненного цикла услуги
+func (s *Server) serviceEvent(w http.ResponseWriter, r *http.Request) {
+	var event priceservice.ServiceEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request")
+		return
+	}
+	switch event.Type {
+	case priceservice.ServiceEventCreated, priceservice.ServiceEventUpdated, priceservice.ServiceEventDeleted:
+	default:
+		writeError(w, http.StatusBadRequest, "unknown event type")
+		return
+	}
+
+	s.mu.Lock()
+	if !s.seenEvents[event.EventID] {
+		s.seenEvents[event.EventID] = true
+		s.events = append(s.events, event)
+		s.deleted[event.ServiceID] = event.Type == priceservice.ServiceEventDeleted
+	}
+	s.mu.Unlock()
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, priceservice.ErrorResponse{Error: message})
+}
@@ -0,0 +1,34 @@
+// Package requestctx переносит идентичность входящего запроса через context
+// к исходящим вызовам (например, в PriceService), не связывая интеграции с HTTP слоем
+package requestctx
+
+import "context"
+
+type contextKey string
+
+const (
+	correlationIDKey contextKey = "correlation_id"
+	userRoleKey      contextKey = "caller_user_role"
+)
+
+// WithCorrelationID сохраняет ID запроса для сквозной корреляции логов
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey, id)
+}
+
+// CorrelationID возвращает ID запроса, если он есть в контексте
+func CorrelationID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(correlationIDKey).(string)
+	return id, ok && id != ""
+}
+
+// WithUserRole сохраняет роль вызывающего пользователя
+func WithUserRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, userRoleKey, role)
+}
+
+// UserRole возвращает роль вызывающего пользователя, если она известна
+func UserRole(ctx context.Context) (string, bool) {
+	role, ok := ctx.Value(userRoleKey).(string)
+	return role, ok && role != ""
+}
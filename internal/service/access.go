@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrAccessDenied возвращается, когда матрица прав не разрешает действие пользователю
+var ErrAccessDenied = errors.New("access denied")
+
+// ManagerChecker проверяет, является ли пользователь менеджером компании
+type ManagerChecker interface {
+	IsManager(ctx context.Context, companyID int64, userID int64) (bool, error)
+}
+
+// CheckAccess проверяет по матрице прав, может ли пользователь выполнить действия над ресурсом компании
+// Для доступа к своей компании менеджерство проверяется через managers.
+// Возвращает ErrAccessDenied или ошибку managers без изменений (например, компания не найдена)
+func CheckAccess(ctx context.Context, managers ManagerChecker, companyID int64, userID int64, userRole string, resource Resource, actions ...Action) error {
+	switch CanAll(userRole, resource, actions...) {
+	case AccessAny:
+		return nil
+	case AccessOwnCompany:
+		// Пользователь должен быть менеджером компании
+	default:
+		return fmt.Errorf("%w: role %q cannot %v %s", ErrAccessDenied, userRole, actions, resource)
+	}
+
+	isManager, err := managers.IsManager(ctx, companyID, userID)
+	if err != nil {
+		return err
+	}
+	if !isManager {
+		return fmt.Errorf("%w: user %d is not a manager of company %d", ErrAccessDenied, userID, companyID)
+	}
+
+	return nil
+}
+
+// CheckAccessAny проверяет, что роль может выполнить действия над ресурсом любой компании
+// Используется для действий, не привязанных к компании пользователя (создание компании, каталог шаблонов)
+func CheckAccessAny(userRole string, resource Resource, actions ...Action) error {
+	if CanAll(userRole, resource, actions...) != AccessAny {
+		return fmt.Errorf("%w: role %q cannot %v any %s", ErrAccessDenied, userRole, actions, resource)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice"
 )
 
 // CompanyRepository интерфейс репозитория компаний
@@ -15,3 +16,13 @@ type CompanyRepository interface {
 	Delete(ctx context.Context, id int64) error
 	IsManager(ctx context.Context, companyID int64, userID int64) (bool, error)
 }
+
+// ServiceRepository интерфейс репозитория услуг
+type ServiceRepository interface {
+	ListActiveIDsByCompanies(ctx context.Context, companyIDs []int64) (map[int64][]int64, error)
+}
+
+// PriceServiceClient интерфейс клиента PriceService
+type PriceServiceClient interface {
+	CalculatePricesBatch(ctx context.Context, reqs []*priceservice.CalculatePricesRequest) []priceservice.CompanyPricesResult
+}
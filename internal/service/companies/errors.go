@@ -1,16 +1,17 @@
 package companies
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/m04kA/SMK-SellerService/internal/service"
+)
 
 var (
 	// ErrCompanyNotFound возвращается, когда компания не найдена
 	ErrCompanyNotFound = errors.New("company not found")
 
-	// ErrAccessDenied возвращается, когда у пользователя нет прав доступа к компании
-	ErrAccessDenied = errors.New("access denied: user is not a manager of this company")
-
-	// ErrOnlySuperuser возвращается, когда операцию может выполнить только superuser
-	ErrOnlySuperuser = errors.New("access denied: only superuser can create companies")
+	// ErrAccessDenied возвращается, когда матрица прав не разрешает действие с компанией
+	ErrAccessDenied = service.ErrAccessDenied
 
 	// ErrInvalidInput возвращается при некорректных входных данных
 	ErrInvalidInput = errors.New("invalid input data")
@@ -11,6 +11,7 @@ type CreateCompanyRequest struct {
 	Name         string                `json:"name"`
 	Logo         *string               `json:"logo,omitempty"`
 	Description  *string               `json:"description,omitempty"`
+	Translations map[string]Translation `json:"translations,omitempty"`
 	Tags         []string              `json:"tags"`
 	Addresses    []AddressInput        `json:"addresses"`
 	WorkingHours WorkingHoursInput     `json:"working_hours"`
@@ -22,6 +23,7 @@ type UpdateCompanyRequest struct {
 	Name         *string               `json:"name,omitempty"`
 	Logo         *string               `json:"logo,omitempty"`
 	Description  *string               `json:"description,omitempty"`
+	Translations map[string]Translation `json:"translations,omitempty"` // {} - удалить переводы
 	Tags         []string              `json:"tags,omitempty"`
 	Addresses    []AddressUpdateInput  `json:"addresses,omitempty"`
 	WorkingHours *WorkingHoursInput    `json:"working_hours,omitempty"`
@@ -34,6 +36,7 @@ type AddressInput struct {
 	Street      string      `json:"street"`
 	Building    string      `json:"building"`
 	Coordinates Coordinates `json:"coordinates"`
+	Capacity    *int        `json:"capacity,omitempty"` // По умолчанию 1
 }
 
 // AddressUpdateInput входные данные для обновления адреса
@@ -43,6 +46,7 @@ type AddressUpdateInput struct {
 	Street      string      `json:"street"`
 	Building    string      `json:"building"`
 	Coordinates Coordinates `json:"coordinates"`
+	Capacity    *int        `json:"capacity,omitempty"` // По умолчанию 1
 }
 
 // Coordinates географические координаты
@@ -51,6 +55,40 @@ type Coordinates struct {
 	Longitude float64 `json:"longitude"`
 }
 
+// Translation перевод названия и описания на один язык (ru, en, kk)
+type Translation struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func toDomainTranslations(translations map[string]Translation) domain.Translations {
+	if translations == nil {
+		return nil
+	}
+	result := make(domain.Translations, len(translations))
+	for locale, t := range translations {
+		result[domain.Locale(locale)] = domain.Translation{
+			Name:        t.Name,
+			Description: t.Description,
+		}
+	}
+	return result
+}
+
+func fromDomainTranslations(translations domain.Translations) map[string]Translation {
+	if len(translations) == 0 {
+		return nil
+	}
+	result := make(map[string]Translation, len(translations))
+	for locale, t := range translations {
+		result[string(locale)] = Translation{
+			Name:        t.Name,
+			Description: t.Description,
+		}
+	}
+	return result
+}
+
 // WorkingHoursInput входные данные для рабочих часов
 type WorkingHoursInput struct {
 	Monday    DaySchedule `json:"monday"`
@@ -75,12 +113,15 @@ type CompanyResponse struct {
 	Name         string                `json:"name"`
 	Logo         *string               `json:"logo,omitempty"`
 	Description  *string               `json:"description,omitempty"`
+	Translations map[string]Translation `json:"translations,omitempty"`
 	Tags         []string              `json:"tags"`
 	Addresses    []AddressResponse     `json:"addresses"`
 	WorkingHours WorkingHoursResponse  `json:"working_hours"`
 	ManagerIDs   []int64               `json:"manager_ids"`
 	CreatedAt    time.Time             `json:"created_at"`
 	UpdatedAt    time.Time             `json:"updated_at"`
+	PriceFrom    *PriceFromResponse    `json:"price_from,omitempty"` // Только при include=price_from
+	Pricing      *PricingInfo          `json:"pricing,omitempty"`    // Только при include=price_from
 }
 
 // AddressResponse ответ с данными адреса
@@ -89,7 +130,18 @@ type AddressResponse struct {
 	City        string      `json:"city"`
 	Street      string      `json:"street"`
 	Building    string      `json:"building"`
-	Coordinates Coordinates `json:"coordinates"`
+	Coordinates Coordinates       `json:"coordinates"`
+	Capacity    int               `json:"capacity"`
+	Bays        BayCountsResponse `json:"bays"`
+}
+
+// BayCountsResponse количество постов адреса по типам
+type BayCountsResponse struct {
+	SelfService int `json:"self_service"`
+	Automatic   int `json:"automatic"`
+	Manual      int `json:"manual"`
+	Detailing   int `json:"detailing"`
+	Total       int `json:"total"`
 }
 
 // WorkingHoursResponse ответ с рабочими часами
@@ -107,6 +159,57 @@ type WorkingHoursResponse struct {
 type CompanyListResponse struct {
 	Companies  []CompanyResponse `json:"companies"`
 	Pagination *PaginationResult `json:"pagination,omitempty"`
+	Pricing    *PricingInfo      `json:"pricing,omitempty"` // Только при include=price_from
+}
+
+// CompanyPublicResponse публичное представление компании
+// Отдаётся всем, кроме менеджеров компании и ролей с правом чтения любых компаний
+type CompanyPublicResponse struct {
+	ID           int64                   `json:"id"`
+	Name         string                  `json:"name"`
+	Logo         *string                 `json:"logo,omitempty"`
+	Description  *string                 `json:"description,omitempty"`
+	Tags         []string                `json:"tags"`
+	Addresses    []AddressPublicResponse `json:"addresses"`
+	WorkingHours WorkingHoursResponse    `json:"working_hours"`
+	PriceFrom    *PriceFromResponse      `json:"price_from,omitempty"` // Только при include=price_from
+	Pricing      *PricingInfo            `json:"pricing,omitempty"`    // Только при include=price_from
+}
+
+// AddressPublicResponse публичное представление адреса
+type AddressPublicResponse struct {
+	ID          int64             `json:"id"`
+	City        string            `json:"city"`
+	Street      string            `json:"street"`
+	Building    string            `json:"building"`
+	Coordinates Coordinates       `json:"coordinates"`
+	Bays        BayCountsResponse `json:"bays"`
+}
+
+// CompanyPublicListResponse публичный ответ со списком компаний
+type CompanyPublicListResponse struct {
+	Companies  []CompanyPublicResponse `json:"companies"`
+	Pagination *PaginationResult       `json:"pagination,omitempty"`
+	Pricing    *PricingInfo            `json:"pricing,omitempty"` // Только при include=price_from
+}
+
+// Статусы обогащения ценами
+const (
+	PricingStatusOK       = "ok"        // PriceService ответил
+	PricingStatusDegraded = "degraded"  // PriceService недоступен или не ответил вовремя, цена может быть из базовых цен услуг
+	PricingStatusNotFound = "not_found" // Цены услуг компании не настроены
+	PricingStatusSkipped  = "skipped"   // Цены не запрашивались (нет активных услуг или пустой список)
+)
+
+// PricingInfo статус обогащения ценами
+type PricingInfo struct {
+	Status string `json:"status"`
+}
+
+// PriceFromResponse минимальная цена среди активных услуг компании ("от N")
+type PriceFromResponse struct {
+	Price    float64 `json:"price"`
+	Currency *string `json:"currency,omitempty"`
 }
 
 // PaginationResult результат пагинации
@@ -119,10 +222,13 @@ type PaginationResult struct {
 
 // CompanyFilterRequest фильтр для списка компаний
 type CompanyFilterRequest struct {
-	Tags  []string `json:"tags,omitempty"`
-	City  *string  `json:"city,omitempty"`
-	Page  *int     `json:"page,omitempty"`
-	Limit *int     `json:"limit,omitempty"`
+	Tags    []string `json:"tags,omitempty"`
+	City    *string  `json:"city,omitempty"`
+	BayType *string  `json:"bay_type,omitempty"`
+	Page    *int     `json:"page,omitempty"`
+	Limit   *int     `json:"limit,omitempty"`
+
+	IncludePriceFrom bool `json:"-"` // Добавить минимальную цену услуг компании
 }
 
 // ToDomainCreateInput конвертирует DTO в domain модель
@@ -137,15 +243,17 @@ func (r *CreateCompanyRequest) ToDomainCreateInput() domain.CreateCompanyInput {
 				Latitude:  addr.Coordinates.Latitude,
 				Longitude: addr.Coordinates.Longitude,
 			},
+			Capacity: toDomainCapacity(addr.Capacity),
 		}
 	}
 
 	return domain.CreateCompanyInput{
 		Name:        r.Name,
 		Logo:        r.Logo,
-		Description: r.Description,
-		Tags:        r.Tags,
-		Addresses:   addresses,
+		Description:  r.Description,
+		Translations: toDomainTranslations(r.Translations),
+		Tags:         r.Tags,
+		Addresses:    addresses,
 		WorkingHours: domain.WorkingHours{
 			Monday:    toDomainDaySchedule(r.WorkingHours.Monday),
 			Tuesday:   toDomainDaySchedule(r.WorkingHours.Tuesday),
@@ -174,6 +282,7 @@ func (r *UpdateCompanyRequest) ToDomainUpdateInput() domain.UpdateCompanyInput {
 					Latitude:  addr.Coordinates.Latitude,
 					Longitude: addr.Coordinates.Longitude,
 				},
+				Capacity: toDomainCapacity(addr.Capacity),
 			}
 		}
 	}
@@ -195,6 +304,7 @@ func (r *UpdateCompanyRequest) ToDomainUpdateInput() domain.UpdateCompanyInput {
 		Name:         r.Name,
 		Logo:         r.Logo,
 		Description:  r.Description,
+		Translations: toDomainTranslations(r.Translations),
 		Tags:         r.Tags,
 		Addresses:    addresses,
 		WorkingHours: workingHours,
@@ -204,11 +314,18 @@ func (r *UpdateCompanyRequest) ToDomainUpdateInput() domain.UpdateCompanyInput {
 
 // ToDomainFilter конвертирует DTO в domain модель
 func (r *CompanyFilterRequest) ToDomainFilter() domain.CompanyFilter {
+	var bayType *domain.BayType
+	if r.BayType != nil {
+		t := domain.BayType(*r.BayType)
+		bayType = &t
+	}
+
 	return domain.CompanyFilter{
-		Tags:  r.Tags,
-		City:  r.City,
-		Page:  r.Page,
-		Limit: r.Limit,
+		Tags:    r.Tags,
+		City:    r.City,
+		BayType: bayType,
+		Page:    r.Page,
+		Limit:   r.Limit,
 	}
 }
 
@@ -225,6 +342,8 @@ func FromDomainCompany(c *domain.Company) *CompanyResponse {
 				Latitude:  addr.Coordinates.Latitude,
 				Longitude: addr.Coordinates.Longitude,
 			},
+			Capacity: addr.Capacity,
+			Bays:     fromDomainBayCounts(addr.BayCounts),
 		}
 	}
 
@@ -232,47 +351,112 @@ func FromDomainCompany(c *domain.Company) *CompanyResponse {
 		ID:          c.ID,
 		Name:        c.Name,
 		Logo:        c.Logo,
-		Description: c.Description,
-		Tags:        c.Tags,
-		Addresses:   addresses,
-		WorkingHours: WorkingHoursResponse{
-			Monday:    fromDomainDaySchedule(c.WorkingHours.Monday),
-			Tuesday:   fromDomainDaySchedule(c.WorkingHours.Tuesday),
-			Wednesday: fromDomainDaySchedule(c.WorkingHours.Wednesday),
-			Thursday:  fromDomainDaySchedule(c.WorkingHours.Thursday),
-			Friday:    fromDomainDaySchedule(c.WorkingHours.Friday),
-			Saturday:  fromDomainDaySchedule(c.WorkingHours.Saturday),
-			Sunday:    fromDomainDaySchedule(c.WorkingHours.Sunday),
-		},
-		ManagerIDs: c.ManagerIDs,
-		CreatedAt:  c.CreatedAt,
-		UpdatedAt:  c.UpdatedAt,
+		Description:  c.Description,
+		Translations: fromDomainTranslations(c.Translations),
+		Tags:         c.Tags,
+		Addresses:    addresses,
+		WorkingHours: fromDomainWorkingHours(c.WorkingHours),
+		ManagerIDs:   c.ManagerIDs,
+		CreatedAt:    c.CreatedAt,
+		UpdatedAt:    c.UpdatedAt,
+	}
+}
+
+// FromDomainCompanyPublic конвертирует публичную domain модель в DTO
+func FromDomainCompanyPublic(c *domain.CompanyPublic) *CompanyPublicResponse {
+	addresses := make([]AddressPublicResponse, len(c.Addresses))
+	for i, addr := range c.Addresses {
+		addresses[i] = AddressPublicResponse{
+			ID:       addr.ID,
+			City:     addr.City,
+			Street:   addr.Street,
+			Building: addr.Building,
+			Coordinates: Coordinates{
+				Latitude:  addr.Coordinates.Latitude,
+				Longitude: addr.Coordinates.Longitude,
+			},
+			Bays: fromDomainBayCounts(addr.BayCounts),
+		}
+	}
+
+	return &CompanyPublicResponse{
+		ID:           c.ID,
+		Name:         c.Name,
+		Logo:         c.Logo,
+		Description:  c.Description,
+		Tags:         c.Tags,
+		Addresses:    addresses,
+		WorkingHours: fromDomainWorkingHours(c.WorkingHours),
 	}
 }
 
 // FromDomainCompanyList конвертирует список domain моделей в DTO
 func FromDomainCompanyList(companies []domain.Company, pagination *domain.PaginationResult) *CompanyListResponse {
 	response := &CompanyListResponse{
-		Companies: make([]CompanyResponse, len(companies)),
+		Companies:  make([]CompanyResponse, len(companies)),
+		Pagination: fromDomainPagination(pagination),
 	}
 
 	for i, c := range companies {
 		response.Companies[i] = *FromDomainCompany(&c)
 	}
 
-	if pagination != nil {
-		totalPages := (pagination.Total + pagination.Limit - 1) / pagination.Limit
-		response.Pagination = &PaginationResult{
-			Page:       pagination.Page,
-			Limit:      pagination.Limit,
-			TotalPages: totalPages,
-			TotalItems: pagination.Total,
-		}
+	return response
+}
+
+// FromDomainCompanyPublicList конвертирует список domain моделей в публичный DTO
+func FromDomainCompanyPublicList(companies []domain.Company, pagination *domain.PaginationResult) *CompanyPublicListResponse {
+	response := &CompanyPublicListResponse{
+		Companies:  make([]CompanyPublicResponse, len(companies)),
+		Pagination: fromDomainPagination(pagination),
+	}
+
+	for i := range companies {
+		response.Companies[i] = *FromDomainCompanyPublic(companies[i].Public())
 	}
 
 	return response
 }
 
+// fromDomainPagination конвертирует результат пагинации, nil - пагинация не применялась
+func fromDomainPagination(pagination *domain.PaginationResult) *PaginationResult {
+	if pagination == nil {
+		return nil
+	}
+
+	totalPages := (pagination.Total + pagination.Limit - 1) / pagination.Limit
+	return &PaginationResult{
+		Page:       pagination.Page,
+		Limit:      pagination.Limit,
+		TotalPages: totalPages,
+		TotalItems: pagination.Total,
+	}
+}
+
+// fromDomainWorkingHours конвертирует рабочие часы по дням недели
+func fromDomainWorkingHours(wh domain.WorkingHours) WorkingHoursResponse {
+	return WorkingHoursResponse{
+		Monday:    fromDomainDaySchedule(wh.Monday),
+		Tuesday:   fromDomainDaySchedule(wh.Tuesday),
+		Wednesday: fromDomainDaySchedule(wh.Wednesday),
+		Thursday:  fromDomainDaySchedule(wh.Thursday),
+		Friday:    fromDomainDaySchedule(wh.Friday),
+		Saturday:  fromDomainDaySchedule(wh.Saturday),
+		Sunday:    fromDomainDaySchedule(wh.Sunday),
+	}
+}
+
+func fromDomainBayCounts(counts map[domain.BayType]int) BayCountsResponse {
+	response := BayCountsResponse{
+		SelfService: counts[domain.BayTypeSelfService],
+		Automatic:   counts[domain.BayTypeAutomatic],
+		Manual:      counts[domain.BayTypeManual],
+		Detailing:   counts[domain.BayTypeDetailing],
+	}
+	response.Total = response.SelfService + response.Automatic + response.Manual + response.Detailing
+	return response
+}
+
 func toDomainDaySchedule(ds DaySchedule) domain.DaySchedule {
 	return domain.DaySchedule{
 		IsOpen:    ds.IsOpen,
@@ -304,3 +488,13 @@ func timeStringToStringPtr(ts *domain.TimeString) *string {
 	s := string(*ts)
 	return &s
 }
+
+// DefaultAddressCapacity вместимость адреса по умолчанию (один пост)
+const DefaultAddressCapacity = 1
+
+func toDomainCapacity(capacity *int) int {
+	if capacity == nil {
+		return DefaultAddressCapacity
+	}
+	return *capacity
+}
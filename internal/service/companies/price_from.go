@@ -0,0 +1,164 @@
+package companies
+
+import (
+	"context"
+	"errors"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice"
+	"github.com/m04kA/SMK-SellerService/internal/service/companies/models"
+)
+
+// companyPriceFrom минимальная цена компании и статус её получения
+type companyPriceFrom struct {
+	PriceFrom *models.PriceFromResponse
+	Pricing   *models.PricingInfo
+}
+
+// priceFromCompanies получает минимальную цену среди активных услуг для каждой компании
+// Результат выровнен по companyIDs. Цены запрашиваются без пользователя, одним пакетом на страницу компаний.
+// Недоступность PriceService не ломает список: компании получают статус degraded
+func (s *Service) priceFromCompanies(ctx context.Context, companyIDs []int64) ([]companyPriceFrom, *models.PricingInfo) {
+	results := make([]companyPriceFrom, len(companyIDs))
+	if len(companyIDs) == 0 {
+		return results, &models.PricingInfo{Status: models.PricingStatusSkipped}
+	}
+
+	serviceIDs, err := s.serviceRepo.ListActiveIDsByCompanies(ctx, companyIDs)
+	if err != nil {
+		// Без списка услуг цены не запросить - отвечаем без них
+		for i := range results {
+			results[i].Pricing = &models.PricingInfo{Status: models.PricingStatusDegraded}
+		}
+		return results, &models.PricingInfo{Status: models.PricingStatusDegraded}
+	}
+
+	reqs := make([]*priceservice.CalculatePricesRequest, 0, len(companyIDs))
+	indexes := make(map[int64]int, len(companyIDs)) // Позиция компании в списке
+	for i, companyID := range companyIDs {
+		ids := serviceIDs[companyID]
+		if len(ids) == 0 {
+			results[i].Pricing = &models.PricingInfo{Status: models.PricingStatusSkipped}
+			continue
+		}
+		reqs = append(reqs, &priceservice.CalculatePricesRequest{
+			CompanyID:  companyID,
+			ServiceIDs: ids,
+		})
+		indexes[companyID] = i
+	}
+
+	if len(reqs) == 0 {
+		return results, &models.PricingInfo{Status: models.PricingStatusSkipped}
+	}
+
+	status := models.PricingStatusOK
+	for _, result := range s.priceClient.CalculatePricesBatch(ctx, reqs) {
+		company := &results[indexes[result.CompanyID]]
+		company.Pricing = &models.PricingInfo{Status: priceFromStatus(result)}
+		if company.Pricing.Status == models.PricingStatusDegraded {
+			status = models.PricingStatusDegraded
+		}
+		if result.Err == nil {
+			company.PriceFrom = minPrice(result.Prices.Prices)
+		}
+	}
+
+	return results, &models.PricingInfo{Status: status}
+}
+
+// enrichWithPriceFrom добавляет компаниям минимальную цену среди их активных услуг
+func (s *Service) enrichWithPriceFrom(ctx context.Context, companies []models.CompanyResponse) *models.PricingInfo {
+	companyIDs := make([]int64, len(companies))
+	for i := range companies {
+		companyIDs[i] = companies[i].ID
+	}
+
+	results, pricing := s.priceFromCompanies(ctx, companyIDs)
+	for i := range companies {
+		companies[i].PriceFrom = results[i].PriceFrom
+		companies[i].Pricing = results[i].Pricing
+	}
+	return pricing
+}
+
+// enrichPublicWithPriceFrom добавляет минимальную цену компаниям публичного списка
+func (s *Service) enrichPublicWithPriceFrom(ctx context.Context, companies []models.CompanyPublicResponse) *models.PricingInfo {
+	companyIDs := make([]int64, len(companies))
+	for i := range companies {
+		companyIDs[i] = companies[i].ID
+	}
+
+	results, pricing := s.priceFromCompanies(ctx, companyIDs)
+	for i := range companies {
+		companies[i].PriceFrom = results[i].PriceFrom
+		companies[i].Pricing = results[i].Pricing
+	}
+	return pricing
+}
+
+// priceFromStatus определяет статус цены компании по результату PriceService
+// Ошибка PriceService уже залогирована в клиенте
+func priceFromStatus(result priceservice.CompanyPricesResult) string {
+	switch {
+	case errors.Is(result.Err, priceservice.ErrPricesNotFound):
+		return models.PricingStatusNotFound
+	case result.Err != nil:
+		return models.PricingStatusDegraded
+	case result.Prices.Degraded:
+		// PriceService недоступен, цена может быть из кэша или базовых цен услуг
+		return models.PricingStatusDegraded
+	case minPrice(result.Prices.Prices) == nil:
+		return models.PricingStatusNotFound
+	default:
+		return models.PricingStatusOK
+	}
+}
+
+// minPrice возвращает минимальную цену среди услуг, nil - если цен нет
+// Суммы в разных валютах несравнимы, поэтому сравниваются только цены в валюте priceFromCurrency
+func minPrice(prices []priceservice.ServicePrice) *models.PriceFromResponse {
+	currency, ok := priceFromCurrency(prices)
+	if !ok {
+		return nil
+	}
+
+	var result *models.PriceFromResponse
+	for _, price := range prices {
+		if price.Price == nil || priceCurrency(price) != currency {
+			continue
+		}
+		if result == nil || *price.Price < result.Price {
+			result = &models.PriceFromResponse{Price: *price.Price, Currency: &currency}
+		}
+	}
+	return result
+}
+
+// priceFromCurrency выбирает валюту минимальной цены: domain.DefaultCurrency, если в ней есть цена,
+// иначе наименьший по алфавиту код валюты. Выбор не зависит от порядка услуг
+func priceFromCurrency(prices []priceservice.ServicePrice) (string, bool) {
+	var currency string
+	found := false
+	for _, price := range prices {
+		if price.Price == nil {
+			continue
+		}
+		c := priceCurrency(price)
+		if c == domain.DefaultCurrency {
+			return c, true
+		}
+		if !found || c < currency {
+			currency, found = c, true
+		}
+	}
+	return currency, found
+}
+
+// priceCurrency возвращает валюту цены, без валюты - domain.DefaultCurrency
+func priceCurrency(price priceservice.ServicePrice) string {
+	if price.Currency == nil || *price.Currency == "" {
+		return domain.DefaultCurrency
+	}
+	return *price.Currency
+}
@@ -0,0 +1,98 @@
+package companies
+
+import (
+	"testing"
+
+	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice"
+)
+
+func TestMinPrice(t *testing.T) {
+	price := func(serviceID int64, value float64, currency string) priceservice.ServicePrice {
+		p := priceservice.ServicePrice{ServiceID: serviceID, Price: &value}
+		if currency != "" {
+			p.Currency = &currency
+		}
+		return p
+	}
+	unpriced := priceservice.ServicePrice{ServiceID: 99}
+
+	tests := []struct {
+		name         string
+		prices       []priceservice.ServicePrice
+		wantNil      bool
+		wantPrice    float64
+		wantCurrency string
+	}{
+		{
+			name:    "no prices",
+			wantNil: true,
+		},
+		{
+			name:    "only services without price",
+			prices:  []priceservice.ServicePrice{unpriced},
+			wantNil: true,
+		},
+		{
+			name:         "minimum in one currency",
+			prices:       []priceservice.ServicePrice{price(1, 2000, "RUB"), price(2, 800, "RUB"), price(3, 1500, "RUB")},
+			wantPrice:    800,
+			wantCurrency: "RUB",
+		},
+		{
+			name:         "services without price are skipped",
+			prices:       []priceservice.ServicePrice{unpriced, price(1, 1200, "RUB"), unpriced},
+			wantPrice:    1200,
+			wantCurrency: "RUB",
+		},
+		{
+			name:         "default currency wins over cheaper foreign price",
+			prices:       []priceservice.ServicePrice{price(1, 10, "USD"), price(2, 2000, "RUB"), price(3, 800, "RUB")},
+			wantPrice:    800,
+			wantCurrency: "RUB",
+		},
+		{
+			name:         "price without currency is in default currency",
+			prices:       []priceservice.ServicePrice{price(1, 2000, "RUB"), price(2, 700, "")},
+			wantPrice:    700,
+			wantCurrency: "RUB",
+		},
+		{
+			name:         "without default currency the first code alphabetically is used",
+			prices:       []priceservice.ServicePrice{price(1, 30, "USD"), price(2, 50, "EUR"), price(3, 40, "EUR"), price(4, 5, "USD")},
+			wantPrice:    40,
+			wantCurrency: "EUR",
+		},
+		{
+			name:         "result does not depend on order",
+			prices:       []priceservice.ServicePrice{price(4, 5, "USD"), price(3, 40, "EUR"), price(1, 30, "USD"), price(2, 50, "EUR")},
+			wantPrice:    40,
+			wantCurrency: "EUR",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := minPrice(tt.prices)
+			if tt.wantNil {
+				if got != nil {
+					t.Fatalf("minPrice() = %+v, want nil", got)
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("minPrice() = nil, want %v %s", tt.wantPrice, tt.wantCurrency)
+			}
+			if got.Price != tt.wantPrice {
+				t.Fatalf("minPrice() price = %v, want %v", got.Price, tt.wantPrice)
+			}
+			gotCurrency := ""
+			if got.Currency != nil {
+				gotCurrency = *got.Currency
+			}
+			if gotCurrency != tt.wantCurrency {
+				t.Fatalf("minPrice() currency = %q, want %q", gotCurrency, tt.wantCurrency)
+			}
+		})
+	}
+}
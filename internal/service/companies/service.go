@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/m04kA/SMK-SellerService/internal/domain"
 	"github.com/m04kA/SMK-SellerService/internal/service"
 	"github.com/m04kA/SMK-SellerService/internal/service/companies/models"
 	companyRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/company"
@@ -12,22 +13,35 @@ import (
 
 type Service struct {
 	companyRepo CompanyRepository
+	serviceRepo ServiceRepository
+	priceClient PriceServiceClient
 }
 
-func NewService(companyRepo CompanyRepository) *Service {
+func NewService(companyRepo CompanyRepository, serviceRepo ServiceRepository, priceClient PriceServiceClient) *Service {
 	return &Service{
 		companyRepo: companyRepo,
+		serviceRepo: serviceRepo,
+		priceClient: priceClient,
 	}
 }
 
 // Create создает новую компанию
 func (s *Service) Create(ctx context.Context, userID int64, userRole string, req *models.CreateCompanyRequest) (*models.CompanyResponse, error) {
-	// Только superuser может создавать компании
-	if userRole != service.RoleSuperuser {
-		return nil, ErrOnlySuperuser
+	// Создание не привязано к компании пользователя: нужен доступ ко всем компаниям
+	if err := service.CheckAccessAny(userRole, service.ResourceCompany, service.ActionCreate); err != nil {
+		return nil, err
 	}
 
 	input := req.ToDomainCreateInput()
+	if err := validateTranslations(input.Translations); err != nil {
+		return nil, err
+	}
+	for _, addr := range input.Addresses {
+		if err := validateCapacity(addr.Capacity); err != nil {
+			return nil, err
+		}
+	}
+
 	company, err := s.companyRepo.Create(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("%w: Create - repository error: %v", ErrInternal, err)
@@ -36,8 +50,87 @@ func (s *Service) Create(ctx context.Context, userID int64, userRole string, req
 	return models.FromDomainCompany(company), nil
 }
 
-// GetByID получает компанию по ID
-func (s *Service) GetByID(ctx context.Context, id int64) (*models.CompanyResponse, error) {
+// GetByID получает компанию по ID в полном представлении
+// Название и описание возвращаются на языке locale, если есть перевод
+func (s *Service) GetByID(ctx context.Context, id int64, locale string) (*models.CompanyResponse, error) {
+	company, err := s.getLocalized(ctx, id, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.FromDomainCompany(company), nil
+}
+
+// GetPublicByID получает компанию по ID в публичном представлении
+// Название и описание возвращаются на языке locale, если есть перевод
+func (s *Service) GetPublicByID(ctx context.Context, id int64, locale string) (*models.CompanyPublicResponse, error) {
+	company, err := s.getLocalized(ctx, id, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.FromDomainCompanyPublic(company.Public()), nil
+}
+
+// List получает список компаний с фильтрацией в полном представлении
+// Название и описание возвращаются на языке locale, если есть перевод
+func (s *Service) List(ctx context.Context, req *models.CompanyFilterRequest, locale string) (*models.CompanyListResponse, error) {
+	companies, pagination, err := s.listLocalized(ctx, req, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	response := models.FromDomainCompanyList(companies, pagination)
+	if req.IncludePriceFrom {
+		response.Pricing = s.enrichWithPriceFrom(ctx, response.Companies)
+	}
+
+	return response, nil
+}
+
+// ListPublic получает список компаний с фильтрацией в публичном представлении
+// Название и описание возвращаются на языке locale, если есть перевод
+func (s *Service) ListPublic(ctx context.Context, req *models.CompanyFilterRequest, locale string) (*models.CompanyPublicListResponse, error) {
+	companies, pagination, err := s.listLocalized(ctx, req, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	response := models.FromDomainCompanyPublicList(companies, pagination)
+	if req.IncludePriceFrom {
+		response.Pricing = s.enrichPublicWithPriceFrom(ctx, response.Companies)
+	}
+
+	return response, nil
+}
+
+// CanViewPrivate проверяет, доступно ли пользователю полное представление компании
+// Полное представление видят менеджеры компании и роли с правом чтения любых компаний.
+// Анонимный пользователь и несуществующая компания - публичное представление
+func (s *Service) CanViewPrivate(ctx context.Context, companyID int64, userID *int64, userRole string) (bool, error) {
+	if userID == nil {
+		return false, nil
+	}
+
+	err := s.checkAccess(ctx, companyID, *userID, userRole, service.ActionRead)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, ErrAccessDenied) || errors.Is(err, ErrCompanyNotFound) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// CanListPrivate проверяет, доступно ли роли полное представление в списке компаний
+// Список содержит чужие компании, поэтому менеджерам он отдаётся в публичном представлении
+func (s *Service) CanListPrivate(userRole string) bool {
+	return service.Can(userRole, service.ActionRead, service.ResourceCompany) == service.AccessAny
+}
+
+// getLocalized получает компанию по ID на языке locale
+func (s *Service) getLocalized(ctx context.Context, id int64, locale string) (*domain.Company, error) {
 	company, err := s.companyRepo.GetByID(ctx, id)
 	if err != nil {
 		// Проверяем, является ли ошибка ErrCompanyNotFound из репозитория
@@ -47,34 +140,55 @@ func (s *Service) GetByID(ctx context.Context, id int64) (*models.CompanyRespons
 		return nil, fmt.Errorf("%w: GetByID - repository error: %v", ErrInternal, err)
 	}
 
-	return models.FromDomainCompany(company), nil
+	localizeCompany(company, locale)
+	return company, nil
 }
 
-// List получает список компаний с фильтрацией
-func (s *Service) List(ctx context.Context, req *models.CompanyFilterRequest) (*models.CompanyListResponse, error) {
+// listLocalized получает список компаний по фильтру на языке locale
+func (s *Service) listLocalized(ctx context.Context, req *models.CompanyFilterRequest, locale string) ([]domain.Company, *domain.PaginationResult, error) {
 	filter := req.ToDomainFilter()
+	if filter.BayType != nil && !filter.BayType.IsValid() {
+		return nil, nil, fmt.Errorf("%w: unknown bay type %q", ErrInvalidInput, *filter.BayType)
+	}
+
 	companies, pagination, err := s.companyRepo.List(ctx, filter)
 	if err != nil {
-		return nil, fmt.Errorf("%w: List - repository error: %v", ErrInternal, err)
+		return nil, nil, fmt.Errorf("%w: List - repository error: %v", ErrInternal, err)
 	}
 
-	return models.FromDomainCompanyList(companies, pagination), nil
+	for i := range companies {
+		localizeCompany(&companies[i], locale)
+	}
+
+	return companies, pagination, nil
 }
 
 // Update обновляет компанию
 func (s *Service) Update(ctx context.Context, id int64, userID int64, userRole string, req *models.UpdateCompanyRequest) (*models.CompanyResponse, error) {
 	// Проверка прав доступа
-	if err := s.checkAccess(ctx, id, userID, userRole); err != nil {
+	if err := s.checkAccess(ctx, id, userID, userRole, service.ActionUpdate); err != nil {
 		return nil, err
 	}
 
 	input := req.ToDomainUpdateInput()
+	if err := validateTranslations(input.Translations); err != nil {
+		return nil, err
+	}
+	for _, addr := range input.Addresses {
+		if err := validateCapacity(addr.Capacity); err != nil {
+			return nil, err
+		}
+	}
+
 	company, err := s.companyRepo.Update(ctx, id, input)
 	if err != nil {
 		// Проверяем, является ли ошибка ErrCompanyNotFound из репозитория
 		if errors.Is(err, companyRepo.ErrCompanyNotFound) {
 			return nil, ErrCompanyNotFound
 		}
+		if errors.Is(err, companyRepo.ErrAddressNotFound) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
 		return nil, fmt.Errorf("%w: Update - repository error: %v", ErrInternal, err)
 	}
 
@@ -83,9 +197,9 @@ func (s *Service) Update(ctx context.Context, id int64, userID int64, userRole s
 
 // Delete удаляет компанию
 func (s *Service) Delete(ctx context.Context, id int64, userID int64, userRole string) error {
-	// Только superuser может удалять компании
-	if userRole != service.RoleSuperuser {
-		return ErrOnlySuperuser
+	// Удалять компании могут только роли с доступом ко всем компаниям
+	if err := service.CheckAccessAny(userRole, service.ResourceCompany, service.ActionDelete); err != nil {
+		return err
 	}
 
 	if err := s.companyRepo.Delete(ctx, id); err != nil {
@@ -99,26 +213,23 @@ func (s *Service) Delete(ctx context.Context, id int64, userID int64, userRole s
 	return nil
 }
 
-// checkAccess проверяет права доступа пользователя к компании
-func (s *Service) checkAccess(ctx context.Context, companyID int64, userID int64, userRole string) error {
-	// Superuser имеет полный доступ
-	if userRole == service.RoleSuperuser {
-		return nil
+// checkAccess проверяет по матрице прав, может ли пользователь выполнить действия над ресурсом компании
+// Решение принимает service.CheckAccess, здесь только ошибки репозитория приводятся к ошибкам сервиса
+func (s *Service) checkAccess(ctx context.Context, companyID int64, userID int64, userRole string, actions ...service.Action) error {
+	err := service.CheckAccess(ctx, s.companyRepo, companyID, userID, userRole, service.ResourceCompany, actions...)
+	if err == nil || errors.Is(err, ErrAccessDenied) {
+		return err
 	}
-
-	// Обычный пользователь должен быть менеджером компании
-	isManager, err := s.companyRepo.IsManager(ctx, companyID, userID)
-	if err != nil {
-		// Проверяем, является ли ошибка ErrCompanyNotFound из репозитория
-		if errors.Is(err, companyRepo.ErrCompanyNotFound) {
-			return ErrCompanyNotFound
-		}
-		return fmt.Errorf("%w: checkAccess - repository error: %v", ErrInternal, err)
+	if errors.Is(err, companyRepo.ErrCompanyNotFound) {
+		return ErrCompanyNotFound
 	}
+	return fmt.Errorf("%w: checkAccess - repository error: %v", ErrInternal, err)
+}
 
-	if !isManager {
-		return ErrAccessDenied
+// validateCapacity проверяет, что на адресе есть хотя бы один пост
+func validateCapacity(capacity int) error {
+	if capacity < 1 {
+		return fmt.Errorf("%w: address capacity must be at least 1", ErrInvalidInput)
 	}
-
 	return nil
 }
@@ -0,0 +1,24 @@
+package companies
+
+import (
+	"fmt"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+)
+
+// validateTranslations проверяет языки и названия в переводах компании
+func validateTranslations(translations domain.Translations) error {
+	if err := translations.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return nil
+}
+
+// localizeCompany подставляет название и описание компании на языке locale
+// Пустой locale означает базовый текст
+func localizeCompany(company *domain.Company, locale string) {
+	if locale == "" {
+		return
+	}
+	company.Name, company.Description = company.Translations.Localize(domain.Locale(locale), company.Name, company.Description)
+}
@@ -3,6 +3,16 @@ package service
 const (
 	// RoleSuperuser роль суперпользователя с полным доступом
 	RoleSuperuser = "superuser"
-	// RoleUser роль обычного пользователя
+	// RoleModerator роль модератора: правит содержимое любых компаний, но не создаёт и не удаляет их
+	RoleModerator = "moderator"
+	// RoleSupportReadonly роль поддержки: видит всё, включая скрытые данные, но ничего не меняет
+	RoleSupportReadonly = "support-readonly"
+	// RoleUser роль обычного пользователя (менеджера своих компаний)
 	RoleUser = "user"
 )
+
+// IsKnownRole проверяет, что роль входит в модель ролей
+func IsKnownRole(role string) bool {
+	_, ok := permissions[role]
+	return ok
+}
@@ -0,0 +1,113 @@
+package service
+
+// Action действие над ресурсом
+type Action string
+
+const (
+	// ActionRead просмотр данных, закрытых от публичного API (скрытые услуги, история изменений)
+	ActionRead   Action = "read"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Resource ресурс, к которому проверяется доступ
+type Resource string
+
+const (
+	ResourceCompany  Resource = "company"
+	ResourceService  Resource = "service"
+	ResourceBay      Resource = "bay"
+	ResourceSchedule Resource = "schedule" // Занятость адресов
+	ResourceTemplate Resource = "template"
+)
+
+// Access итог проверки доступа по матрице прав
+type Access int
+
+const (
+	// AccessDenied действие запрещено
+	AccessDenied Access = iota
+	// AccessOwnCompany действие разрешено, если пользователь - менеджер компании ресурса
+	AccessOwnCompany
+	// AccessAny действие разрешено для любой компании
+	AccessAny
+)
+
+// permission строка матрицы прав
+type permission struct {
+	resource Resource
+	action   Action
+}
+
+// grants набор прав роли
+type grants map[permission]Access
+
+// allActions выдаёт доступ access ко всем действиям над ресурсами
+func allActions(access Access, actions []Action, resources ...Resource) grants {
+	g := make(grants, len(actions)*len(resources))
+	for _, resource := range resources {
+		for _, action := range actions {
+			g[permission{resource, action}] = access
+		}
+	}
+	return g
+}
+
+// merge объединяет наборы прав, более поздние перекрывают ранние
+func merge(sets ...grants) grants {
+	g := make(grants)
+	for _, set := range sets {
+		for p, access := range set {
+			g[p] = access
+		}
+	}
+	return g
+}
+
+var (
+	everyAction   = []Action{ActionRead, ActionCreate, ActionUpdate, ActionDelete}
+	everyResource = []Resource{ResourceCompany, ResourceService, ResourceBay, ResourceSchedule, ResourceTemplate}
+)
+
+// permissions матрица прав (роль, ресурс, действие); отсутствие строки означает запрет
+// Единственное место, где определяется, что может каждая роль
+var permissions = map[string]grants{
+	RoleSuperuser: allActions(AccessAny, everyAction, everyResource...),
+
+	RoleModerator: merge(
+		allActions(AccessAny, []Action{ActionRead}, everyResource...),
+		allActions(AccessAny, []Action{ActionUpdate}, ResourceCompany),
+		allActions(AccessAny, []Action{ActionCreate, ActionUpdate, ActionDelete}, ResourceService),
+	),
+
+	RoleSupportReadonly: allActions(AccessAny, []Action{ActionRead}, everyResource...),
+
+	RoleUser: merge(
+		allActions(AccessAny, []Action{ActionRead}, ResourceTemplate),
+		allActions(AccessOwnCompany, []Action{ActionRead}, ResourceCompany, ResourceService, ResourceBay, ResourceSchedule),
+		allActions(AccessOwnCompany, []Action{ActionUpdate}, ResourceCompany),
+		allActions(AccessOwnCompany, []Action{ActionCreate, ActionUpdate, ActionDelete}, ResourceService, ResourceBay, ResourceSchedule),
+	),
+}
+
+// Can возвращает доступ роли к действию над ресурсом
+// Неизвестная роль, ресурс или действие - AccessDenied
+func Can(role string, action Action, resource Resource) Access {
+	return permissions[role][permission{resource, action}]
+}
+
+// CanAll возвращает доступ роли ко всем действиям сразу - наименьший из них
+func CanAll(role string, resource Resource, actions ...Action) Access {
+	if len(actions) == 0 {
+		return AccessDenied
+	}
+
+	access := AccessAny
+	for _, action := range actions {
+		if a := Can(role, action, resource); a < access {
+			access = a
+		}
+	}
+	return access
+}
@@ -0,0 +1,29 @@
+package pricesync
+
+import (
+	"context"
+	"time"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice"
+)
+
+// OutboxRepository интерфейс outbox событий услуг
+type OutboxRepository interface {
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]domain.ServiceEvent, error)
+	Complete(ctx context.Context, id int64) error
+	Reschedule(ctx context.Context, id int64, nextAttemptAt time.Time, lastError string) error
+	MarkDead(ctx context.Context, id int64, lastError string) error
+}
+
+// PriceServiceClient интерфейс для отправки событий в PriceService
+type PriceServiceClient interface {
+	NotifyServiceEvent(ctx context.Context, event *priceservice.ServiceEventRequest) error
+}
+
+// Logger интерфейс для логирования
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+	Error(format string, v ...interface{})
+}
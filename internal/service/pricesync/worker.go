@@ -0,0 +1,148 @@
+package pricesync
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice"
+)
+
+// Config настройки доставки событий услуг в PriceService
+type Config struct {
+	PollInterval   time.Duration // Период опроса outbox
+	BatchSize      int           // Событий за один опрос
+	RequestTimeout time.Duration // Таймаут отправки одного события
+	BaseDelay      time.Duration // Задержка перед первым повтором
+	MaxDelay       time.Duration // Максимальная задержка между повторами
+}
+
+// Worker доставляет события жизненного цикла услуг из outbox в PriceService
+// Событие записывается в той же транзакции, что и изменение услуги, поэтому
+// недоступность PriceService не блокирует редактирование каталога: доставка
+// повторяется с экспоненциальной задержкой, пока PriceService не примет событие
+type Worker struct {
+	outbox OutboxRepository
+	client PriceServiceClient
+	cfg    Config
+	log    Logger
+	now    func() time.Time
+}
+
+// NewWorker создает новый экземпляр воркера синхронизации
+func NewWorker(outbox OutboxRepository, client PriceServiceClient, cfg Config, log Logger) *Worker {
+	if cfg.BatchSize < 1 {
+		cfg.BatchSize = 1
+	}
+
+	return &Worker{
+		outbox: outbox,
+		client: client,
+		cfg:    cfg,
+		log:    log,
+		now:    time.Now,
+	}
+}
+
+// Run опрашивает outbox до отмены контекста
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Пока пачки доставляются целиком, забираем следующие без ожидания;
+		// при ошибках ждём следующего опроса, чтобы не перебирать весь outbox во время сбоя
+		for w.poll(ctx) && ctx.Err() == nil {
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll забирает и отправляет одну пачку событий
+// Возвращает true, если пачка была полной и доставлена без ошибок
+func (w *Worker) poll(ctx context.Context) bool {
+	// Аренда с запасом на отправку всей пачки: события не уйдут другому экземпляру, пока идёт доставка
+	lease := w.cfg.RequestTimeout*time.Duration(w.cfg.BatchSize) + w.cfg.PollInterval
+
+	events, err := w.outbox.Claim(ctx, w.cfg.BatchSize, lease)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.log.Error("PriceService sync: failed to claim outbox events: %v", err)
+		}
+		return false
+	}
+
+	delivered := 0
+	for i := range events {
+		if ctx.Err() != nil {
+			break
+		}
+		if w.deliver(ctx, &events[i]) {
+			delivered++
+		}
+	}
+
+	return len(events) == w.cfg.BatchSize && delivered == len(events)
+}
+
+// deliver отправляет событие и фиксирует итог в outbox, возвращает true при успешной доставке
+func (w *Worker) deliver(ctx context.Context, event *domain.ServiceEvent) bool {
+	reqCtx, cancel := context.WithTimeout(ctx, w.cfg.RequestTimeout)
+	err := w.client.NotifyServiceEvent(reqCtx, &priceservice.ServiceEventRequest{
+		EventID:    event.ID,
+		Type:       string(event.Type),
+		CompanyID:  event.CompanyID,
+		ServiceID:  event.ServiceID,
+		OccurredAt: event.CreatedAt,
+	})
+	cancel()
+
+	switch {
+	case err == nil:
+		if err := w.outbox.Complete(ctx, event.ID); err != nil {
+			w.log.Error("PriceService sync: failed to complete event_id=%d: %v", event.ID, err)
+			return false
+		}
+		return true
+	case errors.Is(err, priceservice.ErrEventRejected):
+		w.log.Error("PriceService sync: event_id=%d (%s service_id=%d) rejected, giving up: %v", event.ID, event.Type, event.ServiceID, err)
+		if err := w.outbox.MarkDead(ctx, event.ID, err.Error()); err != nil {
+			w.log.Error("PriceService sync: failed to mark event_id=%d dead: %v", event.ID, err)
+		}
+	case ctx.Err() != nil:
+		// Остановка: событие вернётся в работу после окончания аренды
+	default:
+		delay := w.backoff(event.Attempts)
+		w.log.Warn("PriceService sync: attempt %d for event_id=%d (%s service_id=%d) failed, retrying in %s: %v", event.Attempts, event.ID, event.Type, event.ServiceID, delay, err)
+		if err := w.outbox.Reschedule(ctx, event.ID, w.now().Add(delay), err.Error()); err != nil {
+			w.log.Error("PriceService sync: failed to reschedule event_id=%d: %v", event.ID, err)
+		}
+	}
+
+	return false
+}
+
+// backoff возвращает задержку перед попыткой после attempts неудачных
+// Экспоненциальный рост от BaseDelay до MaxDelay со случайным разбросом в [d/2, d]
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.cfg.BaseDelay
+	for i := 1; i < attempts && delay < w.cfg.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > w.cfg.MaxDelay {
+		delay = w.cfg.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + time.Duration(rand.Int64N(int64(delay-half)+1))
+}
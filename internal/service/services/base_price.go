@@ -0,0 +1,47 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+)
+
+// validateBasePrice проверяет базовую цену и нормализует код валюты (ISO 4217, верхний регистр)
+// nil остаётся nil, чтобы при обновлении не затирать сохранённые значения
+func validateBasePrice(price *float64, currency *string) (*string, error) {
+	if price != nil && !isValidPrice(*price) {
+		return nil, fmt.Errorf("%w: base_price must be a non-negative number", ErrInvalidInput)
+	}
+	if currency == nil {
+		return nil, nil
+	}
+
+	code := strings.ToUpper(*currency)
+	if len(code) != 3 {
+		return nil, fmt.Errorf("%w: base_currency must be a 3-letter ISO 4217 code", ErrInvalidInput)
+	}
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return nil, fmt.Errorf("%w: base_currency must be a 3-letter ISO 4217 code", ErrInvalidInput)
+		}
+	}
+
+	return &code, nil
+}
+
+// validateBasePriceUpdate проверяет базовую цену в обновлении услуги и нормализует код валюты
+// Сброс базовой цены нельзя совмещать с новыми значениями в том же запросе
+func validateBasePriceUpdate(input *domain.UpdateServiceInput) error {
+	if input.ClearBasePrice && (input.BasePrice != nil || input.BaseCurrency != nil) {
+		return fmt.Errorf("%w: clear_base_price cannot be combined with base_price or base_currency", ErrInvalidInput)
+	}
+
+	baseCurrency, err := validateBasePrice(input.BasePrice, input.BaseCurrency)
+	if err != nil {
+		return err
+	}
+	input.BaseCurrency = baseCurrency
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	serviceRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/service"
+	"github.com/m04kA/SMK-SellerService/internal/service"
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+// MaxBatchOperations максимальное количество операций в одном пакете
+const MaxBatchOperations = 100
+
+// Batch выполняет пакет операций create/update/delete над услугами компании в одной транзакции
+// Права доступа проверяются один раз на весь пакет: нужны права на все виды операций в нём.
+// atomic=true: любая ошибка откатывает весь пакет; atomic=false: успешные операции фиксируются.
+func (s *Service) Batch(ctx context.Context, companyID int64, userID int64, userRole string, req *models.BatchServicesRequest, atomic bool) (*models.BatchServicesResponse, error) {
+	// Проверка прав доступа к компании
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceService, batchActions(req.Operations)...); err != nil {
+		return nil, err
+	}
+
+	if len(req.Operations) == 0 {
+		return nil, fmt.Errorf("%w: batch must contain at least one operation", ErrInvalidInput)
+	}
+	if len(req.Operations) > MaxBatchOperations {
+		return nil, fmt.Errorf("%w: batch must contain at most %d operations", ErrInvalidInput, MaxBatchOperations)
+	}
+
+	response := &models.BatchServicesResponse{
+		Atomic:  atomic,
+		Results: make([]models.BatchOperationResult, len(req.Operations)),
+	}
+
+	// Валидируем операции до обращения к БД
+	operations := make([]domain.ServiceBatchOperation, 0, len(req.Operations))
+	indexes := make([]int, 0, len(req.Operations)) // Позиция операции в исходном запросе
+	for i := range req.Operations {
+		opReq := &req.Operations[i]
+		response.Results[i] = models.BatchOperationResult{
+			Index:     i,
+			Op:        opReq.Op,
+			ServiceID: opReq.ServiceID,
+		}
+
+		op, err := toBatchOperation(opReq)
+		if err != nil {
+			response.Results[i].Status = models.BatchStatusFailed
+			response.Results[i].Error = toBatchOperationError(err)
+			continue
+		}
+
+		operations = append(operations, op)
+		indexes = append(indexes, i)
+	}
+
+	// В атомарном режиме невалидная операция отменяет весь пакет
+	if len(operations) == 0 || (atomic && len(operations) != len(req.Operations)) {
+		for _, i := range indexes {
+			response.Results[i].Status = models.BatchStatusSkipped
+		}
+		return response, nil
+	}
+
+	outcome, err := s.serviceRepo.Batch(ctx, companyID, operations, atomic)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Batch - repository error: %v", ErrInternal, err)
+	}
+
+	// Изменённые и удалённые услуги больше не должны отдавать закэшированные цены
+	if outcome.Committed {
+		for j, res := range outcome.Results {
+			op := operations[j]
+			if res.Executed && res.Err == nil && op.ServiceID != nil && op.Type != domain.ServiceBatchCreate {
+				s.priceCache.InvalidateService(*op.ServiceID)
+			}
+		}
+	}
+
+	for j, res := range outcome.Results {
+		result := &response.Results[indexes[j]]
+
+		switch {
+		case !res.Executed:
+			result.Status = models.BatchStatusSkipped
+		case res.Err != nil:
+			result.Status = models.BatchStatusFailed
+			result.Error = toBatchOperationError(res.Err)
+		case !outcome.Committed:
+			result.Status = models.BatchStatusRolledBack
+		default:
+			result.Status = models.BatchStatusOK
+			if res.Service != nil {
+				result.Service = models.FromDomainService(res.Service)
+				result.ServiceID = &res.Service.ID
+			}
+		}
+	}
+	response.Committed = outcome.Committed
+
+	return response, nil
+}
+
+// toBatchOperation валидирует операцию пакета и конвертирует её в domain модель
+func toBatchOperation(req *models.BatchOperationRequest) (domain.ServiceBatchOperation, error) {
+	op := domain.ServiceBatchOperation{
+		Type:      domain.ServiceBatchOperationType(req.Op),
+		ServiceID: req.ServiceID,
+	}
+
+	switch op.Type {
+	case domain.ServiceBatchCreate:
+		if req.ServiceID != nil {
+			return op, fmt.Errorf("%w: service_id must not be set for create", ErrInvalidInput)
+		}
+		if req.Service == nil || req.Service.Name == nil || *req.Service.Name == "" {
+			return op, fmt.Errorf("%w: service.name is required for create", ErrInvalidInput)
+		}
+		input := req.ToCreateServiceRequest().ToDomainCreateInput()
+		vehicleClasses, err := normalizeVehicleClasses(input.VehicleClasses)
+		if err != nil {
+			return op, err
+		}
+		input.VehicleClasses = vehicleClasses
+		if err := validateTranslations(input.Translations); err != nil {
+			return op, err
+		}
+		baseCurrency, err := validateBasePrice(input.BasePrice, input.BaseCurrency)
+		if err != nil {
+			return op, err
+		}
+		input.BaseCurrency = baseCurrency
+		if input.BasePrice != nil && input.BaseCurrency == nil {
+			defaultCurrency := domain.DefaultCurrency
+			input.BaseCurrency = &defaultCurrency
+		}
+		op.Create = &input
+	case domain.ServiceBatchUpdate:
+		if req.ServiceID == nil {
+			return op, fmt.Errorf("%w: service_id is required for update", ErrInvalidInput)
+		}
+		if req.Service == nil {
+			return op, fmt.Errorf("%w: service is required for update", ErrInvalidInput)
+		}
+		input := req.Service.ToDomainUpdateInput()
+		vehicleClasses, err := normalizeVehicleClasses(input.VehicleClasses)
+		if err != nil {
+			return op, err
+		}
+		input.VehicleClasses = vehicleClasses
+		if err := validateTranslations(input.Translations); err != nil {
+			return op, err
+		}
+		if err := validateBasePriceUpdate(&input); err != nil {
+			return op, err
+		}
+		op.Update = &input
+	case domain.ServiceBatchDelete:
+		if req.ServiceID == nil {
+			return op, fmt.Errorf("%w: service_id is required for delete", ErrInvalidInput)
+		}
+	default:
+		return op, fmt.Errorf("%w: unknown op %q, expected create, update or delete", ErrInvalidInput, req.Op)
+	}
+
+	return op, nil
+}
+
+// toBatchOperationError конвертирует ошибку операции в DTO
+func toBatchOperationError(err error) *models.BatchOperationError {
+	var ownershipErr *serviceRepo.AddressOwnershipError
+
+	switch {
+	case errors.Is(err, ErrInvalidInput):
+		return &models.BatchOperationError{Code: models.BatchErrorInvalidInput, Message: err.Error()}
+	case errors.Is(err, serviceRepo.ErrServiceNotFound):
+		return &models.BatchOperationError{Code: models.BatchErrorNotFound, Message: ErrServiceNotFound.Error()}
+	case errors.As(err, &ownershipErr):
+		return &models.BatchOperationError{
+			Code:       models.BatchErrorAddressNotOwned,
+			Message:    ErrAddressNotOwned.Error(),
+			AddressIDs: ownershipErr.AddressIDs,
+		}
+	default:
+		return &models.BatchOperationError{Code: models.BatchErrorInternal, Message: "internal error"}
+	}
+}
+
+// batchActions возвращает действия, которые выполняет пакет
+// Неизвестные операции проверяются как изменение и затем отклоняются валидацией
+func batchActions(operations []models.BatchOperationRequest) []service.Action {
+	seen := make(map[service.Action]bool, 3)
+	actions := make([]service.Action, 0, 3)
+	for i := range operations {
+		action := service.ActionUpdate
+		switch domain.ServiceBatchOperationType(operations[i].Op) {
+		case domain.ServiceBatchCreate:
+			action = service.ActionCreate
+		case domain.ServiceBatchDelete:
+			action = service.ActionDelete
+		}
+		if !seen[action] {
+			seen[action] = true
+			actions = append(actions, action)
+		}
+	}
+	if len(actions) == 0 {
+		actions = append(actions, service.ActionUpdate)
+	}
+	return actions
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	bayRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/bay"
+	"github.com/m04kA/SMK-SellerService/internal/service"
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+// CreateBay создает пост на адресе компании
+func (s *Service) CreateBay(ctx context.Context, companyID int64, addressID int64, userID int64, userRole string, req *models.CreateBayRequest) (*models.BayResponse, error) {
+	// Проверка прав доступа к компании
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceBay, service.ActionCreate); err != nil {
+		return nil, err
+	}
+
+	input := req.ToDomainCreateInput()
+	if strings.TrimSpace(input.Name) == "" {
+		return nil, fmt.Errorf("%w: bay name is required", ErrInvalidInput)
+	}
+	if !input.Type.IsValid() {
+		return nil, fmt.Errorf("%w: unknown bay type %q", ErrInvalidInput, input.Type)
+	}
+
+	bay, err := s.bayRepo.Create(ctx, companyID, addressID, input)
+	if err != nil {
+		return nil, toBayError("CreateBay", err)
+	}
+
+	return models.FromDomainBay(bay), nil
+}
+
+// GetBay получает пост адреса по ID
+func (s *Service) GetBay(ctx context.Context, companyID int64, addressID int64, bayID int64) (*models.BayResponse, error) {
+	bay, err := s.bayRepo.GetByID(ctx, companyID, addressID, bayID)
+	if err != nil {
+		return nil, toBayError("GetBay", err)
+	}
+
+	return models.FromDomainBay(bay), nil
+}
+
+// ListBays получает посты адреса компании
+func (s *Service) ListBays(ctx context.Context, companyID int64, addressID int64) (*models.BayListResponse, error) {
+	bays, err := s.bayRepo.ListByAddress(ctx, companyID, addressID)
+	if err != nil {
+		return nil, toBayError("ListBays", err)
+	}
+
+	return models.FromDomainBayList(bays), nil
+}
+
+// UpdateBay обновляет пост
+func (s *Service) UpdateBay(ctx context.Context, companyID int64, addressID int64, bayID int64, userID int64, userRole string, req *models.UpdateBayRequest) (*models.BayResponse, error) {
+	// Проверка прав доступа к компании
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceBay, service.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	input := req.ToDomainUpdateInput()
+	if input.Name != nil && strings.TrimSpace(*input.Name) == "" {
+		return nil, fmt.Errorf("%w: bay name must not be empty", ErrInvalidInput)
+	}
+	if input.Type != nil && !input.Type.IsValid() {
+		return nil, fmt.Errorf("%w: unknown bay type %q", ErrInvalidInput, *input.Type)
+	}
+
+	bay, err := s.bayRepo.Update(ctx, companyID, addressID, bayID, input)
+	if err != nil {
+		return nil, toBayError("UpdateBay", err)
+	}
+
+	return models.FromDomainBay(bay), nil
+}
+
+// DeleteBay удаляет пост
+func (s *Service) DeleteBay(ctx context.Context, companyID int64, addressID int64, bayID int64, userID int64, userRole string) error {
+	// Проверка прав доступа к компании
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceBay, service.ActionDelete); err != nil {
+		return err
+	}
+
+	if err := s.bayRepo.Delete(ctx, companyID, addressID, bayID); err != nil {
+		return toBayError("DeleteBay", err)
+	}
+
+	return nil
+}
+
+// toBayError конвертирует ошибку репозитория постов в ошибку сервиса
+func toBayError(method string, err error) error {
+	if errors.Is(err, bayRepo.ErrAddressNotFound) {
+		return ErrAddressNotFound
+	}
+	if errors.Is(err, bayRepo.ErrBayNotFound) {
+		return ErrBayNotFound
+	}
+	var servicesErr *bayRepo.BayServicesError
+	if errors.As(err, &servicesErr) {
+		return &BayServicesError{ServiceIDs: servicesErr.ServiceIDs}
+	}
+	return fmt.Errorf("%w: %s - repository error: %v", ErrInternal, method, err)
+}
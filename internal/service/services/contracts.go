@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"github.com/m04kA/SMK-SellerService/internal/domain"
 	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice"
@@ -11,9 +12,12 @@ import (
 type ServiceRepository interface {
 	Create(ctx context.Context, companyID int64, input domain.CreateServiceInput) (*domain.Service, error)
 	GetByID(ctx context.Context, companyID int64, serviceID int64) (*domain.Service, error)
-	ListByCompany(ctx context.Context, companyID int64) ([]domain.Service, error)
+	ListByCompany(ctx context.Context, companyID int64, filter domain.ServiceFilter) ([]domain.Service, error)
 	Update(ctx context.Context, companyID int64, serviceID int64, input domain.UpdateServiceInput) (*domain.Service, error)
 	Delete(ctx context.Context, companyID int64, serviceID int64) error
+	Batch(ctx context.Context, companyID int64, operations []domain.ServiceBatchOperation, atomic bool) (*domain.ServiceBatchOutcome, error)
+	ListRevisions(ctx context.Context, companyID int64, serviceID int64) ([]domain.ServiceRevision, error)
+	GetAsOf(ctx context.Context, companyID int64, serviceID int64, asOf time.Time) (*domain.ServiceRevision, error)
 }
 
 // CompanyRepository интерфейс для проверки прав доступа к компании
@@ -22,7 +26,26 @@ type CompanyRepository interface {
 	GetByID(ctx context.Context, id int64) (*domain.Company, error)
 }
 
+// TemplateRepository интерфейс для чтения шаблонов услуг
+type TemplateRepository interface {
+	GetByID(ctx context.Context, id int64) (*domain.ServiceTemplate, error)
+}
+
+// BayRepository интерфейс репозитория постов адресов
+type BayRepository interface {
+	Create(ctx context.Context, companyID int64, addressID int64, input domain.CreateBayInput) (*domain.Bay, error)
+	GetByID(ctx context.Context, companyID int64, addressID int64, bayID int64) (*domain.Bay, error)
+	ListByAddress(ctx context.Context, companyID int64, addressID int64) ([]domain.Bay, error)
+	Update(ctx context.Context, companyID int64, addressID int64, bayID int64, input domain.UpdateBayInput) (*domain.Bay, error)
+	Delete(ctx context.Context, companyID int64, addressID int64, bayID int64) error
+}
+
 // PriceServiceClient интерфейс для интеграции с PriceService
 type PriceServiceClient interface {
 	CalculatePricesWithGracefulDegradation(ctx context.Context, req *priceservice.CalculatePricesRequest) (*priceservice.CalculatePricesResponse, error)
 }
+
+// PriceCache интерфейс кэша цен PriceService
+type PriceCache interface {
+	InvalidateService(serviceID int64)
+}
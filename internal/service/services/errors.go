@@ -1,6 +1,11 @@
 package services
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/m04kA/SMK-SellerService/internal/service"
+)
 
 var (
 	// ErrServiceNotFound возвращается, когда услуга не найдена
@@ -9,12 +14,58 @@ var (
 	// ErrCompanyNotFound возвращается, когда компания не найдена
 	ErrCompanyNotFound = errors.New("company not found")
 
-	// ErrAccessDenied возвращается, когда у пользователя нет прав доступа к услуге/компании
-	ErrAccessDenied = errors.New("access denied: user is not a manager of this company")
+	// ErrRevisionNotFound возвращается, когда услуга не существовала на запрошенный момент
+	ErrRevisionNotFound = errors.New("service did not exist at the requested time")
+
+	// ErrTemplateNotFound возвращается, когда шаблон услуги не найден
+	ErrTemplateNotFound = errors.New("service template not found")
+
+	// ErrAddressNotFound возвращается, когда адрес не найден у компании
+	ErrAddressNotFound = errors.New("address not found")
+
+	// ErrBayNotFound возвращается, когда пост не найден на адресе
+	ErrBayNotFound = errors.New("bay not found")
+
+	// ErrAccessDenied возвращается, когда матрица прав не разрешает действие с услугой/компанией
+	ErrAccessDenied = service.ErrAccessDenied
 
 	// ErrInvalidInput возвращается при некорректных входных данных
 	ErrInvalidInput = errors.New("invalid input data")
 
+	// ErrAddressNotOwned возвращается, когда услугу пытаются привязать к адресу другой компании
+	ErrAddressNotOwned = errors.New("address does not belong to company")
+
 	// ErrInternal возвращается при внутренних ошибках сервиса
 	ErrInternal = errors.New("service: internal error")
 )
+
+// AddressOwnershipError содержит ID адресов, не принадлежащих компании
+type AddressOwnershipError struct {
+	AddressIDs []int64
+}
+
+func (e *AddressOwnershipError) Error() string {
+	return fmt.Sprintf("%v: address_ids=%v", ErrAddressNotOwned, e.AddressIDs)
+}
+
+// Unwrap позволяет проверять ошибку через errors.Is(err, ErrAddressNotOwned)
+func (e *AddressOwnershipError) Unwrap() error {
+	return ErrAddressNotOwned
+}
+
+// ErrServiceNotAtAddress возвращается, когда пост пытаются связать с услугой, не оказываемой на его адресе
+var ErrServiceNotAtAddress = errors.New("service is not offered at address")
+
+// BayServicesError содержит ID услуг, которые не оказываются на адресе поста
+type BayServicesError struct {
+	ServiceIDs []int64
+}
+
+func (e *BayServicesError) Error() string {
+	return fmt.Sprintf("%v: service_ids=%v", ErrServiceNotAtAddress, e.ServiceIDs)
+}
+
+// Unwrap позволяет проверять ошибку через errors.Is(err, ErrServiceNotAtAddress)
+func (e *BayServicesError) Unwrap() error {
+	return ErrServiceNotAtAddress
+}
@@ -0,0 +1,92 @@
+package models
+
+import (
+	"time"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+)
+
+// CreateBayRequest запрос на создание поста
+type CreateBayRequest struct {
+	Name       string  `json:"name"`
+	Type       string  `json:"type"` // self_service | automatic | manual | detailing
+	ServiceIDs []int64 `json:"service_ids"`
+}
+
+// UpdateBayRequest запрос на обновление поста
+type UpdateBayRequest struct {
+	Name       *string `json:"name,omitempty"`
+	Type       *string `json:"type,omitempty"`
+	ServiceIDs []int64 `json:"service_ids,omitempty"` // [] - отвязать все услуги
+}
+
+// BayResponse ответ с данными поста
+type BayResponse struct {
+	ID         int64     `json:"id"`
+	AddressID  int64     `json:"address_id"`
+	Name       string    `json:"name"`
+	Type       string    `json:"type"`
+	ServiceIDs []int64   `json:"service_ids"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// BayListResponse ответ со списком постов адреса
+type BayListResponse struct {
+	Bays []BayResponse `json:"bays"`
+}
+
+// ToDomainCreateInput конвертирует DTO в domain модель
+func (r *CreateBayRequest) ToDomainCreateInput() domain.CreateBayInput {
+	return domain.CreateBayInput{
+		Name:       r.Name,
+		Type:       domain.BayType(r.Type),
+		ServiceIDs: r.ServiceIDs,
+	}
+}
+
+// ToDomainUpdateInput конвертирует DTO в domain модель
+func (r *UpdateBayRequest) ToDomainUpdateInput() domain.UpdateBayInput {
+	var bayType *domain.BayType
+	if r.Type != nil {
+		t := domain.BayType(*r.Type)
+		bayType = &t
+	}
+
+	return domain.UpdateBayInput{
+		Name:       r.Name,
+		Type:       bayType,
+		ServiceIDs: r.ServiceIDs,
+	}
+}
+
+// FromDomainBay конвертирует domain модель в DTO
+func FromDomainBay(b *domain.Bay) *BayResponse {
+	serviceIDs := b.ServiceIDs
+	if serviceIDs == nil {
+		serviceIDs = []int64{}
+	}
+
+	return &BayResponse{
+		ID:         b.ID,
+		AddressID:  b.AddressID,
+		Name:       b.Name,
+		Type:       string(b.Type),
+		ServiceIDs: serviceIDs,
+		CreatedAt:  b.CreatedAt,
+		UpdatedAt:  b.UpdatedAt,
+	}
+}
+
+// FromDomainBayList конвертирует список domain моделей в DTO
+func FromDomainBayList(bays []domain.Bay) *BayListResponse {
+	response := &BayListResponse{
+		Bays: make([]BayResponse, len(bays)),
+	}
+
+	for i, b := range bays {
+		response.Bays[i] = *FromDomainBay(&b)
+	}
+
+	return response
+}
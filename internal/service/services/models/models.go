@@ -8,50 +8,184 @@ import (
 
 // CreateServiceRequest запрос на создание услуги
 type CreateServiceRequest struct {
-	Name            string  `json:"name"`
-	Description     *string `json:"description,omitempty"`
-	AverageDuration *int    `json:"average_duration,omitempty"`
-	AddressIDs      []int64 `json:"address_ids"`
+	Name            string                 `json:"name"`
+	Description     *string                `json:"description,omitempty"`
+	Translations    map[string]Translation `json:"translations,omitempty"`
+	AverageDuration *int                   `json:"average_duration,omitempty"`
+	AddressIDs      []int64                `json:"address_ids"`
+	IsActive        *bool                  `json:"is_active,omitempty"`       // По умолчанию true
+	VehicleClasses  []string               `json:"vehicle_classes,omitempty"` // Пустой список - любой класс
+	BasePrice       *float64               `json:"base_price,omitempty"`      // Запасная цена, если PriceService недоступен
+	BaseCurrency    *string                `json:"base_currency,omitempty"`   // По умолчанию RUB
 }
 
 // UpdateServiceRequest запрос на обновление услуги
 type UpdateServiceRequest struct {
-	Name            *string `json:"name,omitempty"`
-	Description     *string `json:"description,omitempty"`
-	AverageDuration *int    `json:"average_duration,omitempty"`
-	AddressIDs      []int64 `json:"address_ids,omitempty"`
+	Name            *string                `json:"name,omitempty"`
+	Description     *string                `json:"description,omitempty"`
+	Translations    map[string]Translation `json:"translations,omitempty"` // {} - удалить переводы
+	AverageDuration *int                   `json:"average_duration,omitempty"`
+	AddressIDs      []int64                `json:"address_ids,omitempty"`
+	IsActive        *bool                  `json:"is_active,omitempty"`
+	VehicleClasses  []string               `json:"vehicle_classes,omitempty"` // [] - сбросить ограничение по классам
+	BasePrice       *float64               `json:"base_price,omitempty"`
+	BaseCurrency    *string                `json:"base_currency,omitempty"`
+	ClearBasePrice  bool                   `json:"clear_base_price,omitempty"` // true - удалить базовую цену и валюту
+}
+
+// CreateFromTemplateRequest запрос на создание услуги из шаблона
+// Незаданные поля берутся из шаблона
+type CreateFromTemplateRequest struct {
+	Name            *string                `json:"name,omitempty"`
+	Description     *string                `json:"description,omitempty"`
+	Translations    map[string]Translation `json:"translations,omitempty"`
+	AverageDuration *int                   `json:"average_duration,omitempty"`
+	AddressIDs      []int64                `json:"address_ids"`
+	IsActive        *bool                  `json:"is_active,omitempty"`
+	VehicleClasses  []string               `json:"vehicle_classes,omitempty"`
+	BasePrice       *float64               `json:"base_price,omitempty"`
+	BaseCurrency    *string                `json:"base_currency,omitempty"`
 }
 
 // ServiceResponse ответ с данными услуги
 type ServiceResponse struct {
-	ID              int64     `json:"id"`
-	CompanyID       int64     `json:"company_id"`
-	Name            string    `json:"name"`
-	Description     *string   `json:"description,omitempty"`
-	AverageDuration *int      `json:"average_duration,omitempty"`
-	AddressIDs      []int64   `json:"address_ids"`
-	CreatedAt       time.Time `json:"created_at"`
-	UpdatedAt       time.Time `json:"updated_at"`
+	ID              int64                  `json:"id"`
+	CompanyID       int64                  `json:"company_id"`
+	Name            string                 `json:"name"`
+	Description     *string                `json:"description,omitempty"`
+	Translations    map[string]Translation `json:"translations,omitempty"`
+	AverageDuration *int                   `json:"average_duration,omitempty"`
+	AddressIDs      []int64                `json:"address_ids"`
+	IsActive        bool                   `json:"is_active"`
+	TemplateID      *int64                 `json:"template_id,omitempty"`
+	VehicleClasses  []string               `json:"vehicle_classes"`
+	BasePrice       *float64               `json:"base_price,omitempty"`
+	BaseCurrency    *string                `json:"base_currency,omitempty"`
+	Revision        *int                   `json:"revision,omitempty"` // Номер ревизии для запроса на момент времени
+	CreatedAt       time.Time              `json:"created_at"`
+	UpdatedAt       time.Time              `json:"updated_at"`
 	// Price fields (optional, populated when PriceService is available)
 	Price             *float64 `json:"price,omitempty"`
 	Currency          *string  `json:"currency,omitempty"`
 	PricingType       *string  `json:"pricing_type,omitempty"`
 	VehicleClass      *string  `json:"vehicle_class,omitempty"`
 	AppliedMultiplier *float64 `json:"applied_multiplier,omitempty"`
+	// Статус обогащения ценами (только для чтения каталога)
+	Pricing *PricingInfo `json:"pricing,omitempty"`
+}
+
+// Translation перевод названия и описания на один язык (ru, en, kk)
+type Translation struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+func toDomainTranslations(translations map[string]Translation) domain.Translations {
+	if translations == nil {
+		return nil
+	}
+	result := make(domain.Translations, len(translations))
+	for locale, t := range translations {
+		result[domain.Locale(locale)] = domain.Translation{
+			Name:        t.Name,
+			Description: t.Description,
+		}
+	}
+	return result
+}
+
+func fromDomainTranslations(translations domain.Translations) map[string]Translation {
+	if len(translations) == 0 {
+		return nil
+	}
+	result := make(map[string]Translation, len(translations))
+	for locale, t := range translations {
+		result[string(locale)] = Translation{
+			Name:        t.Name,
+			Description: t.Description,
+		}
+	}
+	return result
+}
+
+// ServiceRevisionResponse ревизия услуги
+type ServiceRevisionResponse struct {
+	Revision  int             `json:"revision"`
+	ValidFrom time.Time       `json:"valid_from"`
+	Deleted   bool            `json:"deleted,omitempty"` // Услуга удалена с valid_from, service - последнее состояние
+	Service   ServiceResponse `json:"service"`
+}
+
+// ServiceRevisionListResponse ответ с историей услуги
+type ServiceRevisionListResponse struct {
+	Revisions []ServiceRevisionResponse `json:"revisions"`
+}
+
+// FromDomainRevision конвертирует ревизию в снимок услуги с номером ревизии
+func FromDomainRevision(r *domain.ServiceRevision) *ServiceResponse {
+	response := FromDomainService(&r.Service)
+	revision := r.Revision
+	response.Revision = &revision
+	return response
+}
+
+// FromDomainRevisionList конвертирует историю услуги в DTO
+func FromDomainRevisionList(revisions []domain.ServiceRevision) *ServiceRevisionListResponse {
+	response := &ServiceRevisionListResponse{
+		Revisions: make([]ServiceRevisionResponse, len(revisions)),
+	}
+
+	for i, r := range revisions {
+		response.Revisions[i] = ServiceRevisionResponse{
+			Revision:  r.Revision,
+			ValidFrom: r.ValidFrom,
+			Deleted:   r.Deleted,
+			Service:   *FromDomainService(&r.Service),
+		}
+	}
+
+	return response
+}
+
+// ServiceFilterRequest фильтр для списка услуг компании
+type ServiceFilterRequest struct {
+	VehicleClass *string  `json:"vehicle_class,omitempty"`
+	MinPrice     *float64 `json:"min_price,omitempty"` // Применяется после обогащения ценами
+	MaxPrice     *float64 `json:"max_price,omitempty"`
+	Sort         *string  `json:"sort,omitempty"` // price_asc | price_desc
 }
 
 // ServiceListResponse ответ со списком услуг
 type ServiceListResponse struct {
 	Services []ServiceResponse `json:"services"`
+	Pricing  *PricingInfo      `json:"pricing,omitempty"`
 }
 
 // ToDomainCreateInput конвертирует DTO в domain модель
 func (r *CreateServiceRequest) ToDomainCreateInput() domain.CreateServiceInput {
+	// Новая услуга по умолчанию видна в каталоге
+	isActive := true
+	if r.IsActive != nil {
+		isActive = *r.IsActive
+	}
+
 	return domain.CreateServiceInput{
 		Name:            r.Name,
 		Description:     r.Description,
+		Translations:    toDomainTranslations(r.Translations),
 		AverageDuration: r.AverageDuration,
 		AddressIDs:      r.AddressIDs,
+		IsActive:        isActive,
+		VehicleClasses:  r.VehicleClasses,
+		BasePrice:       r.BasePrice,
+		BaseCurrency:    r.BaseCurrency,
+	}
+}
+
+// ToDomainFilter конвертирует DTO в domain модель
+func (r *ServiceFilterRequest) ToDomainFilter() domain.ServiceFilter {
+	return domain.ServiceFilter{
+		VehicleClass: r.VehicleClass,
 	}
 }
 
@@ -60,20 +194,65 @@ func (r *UpdateServiceRequest) ToDomainUpdateInput() domain.UpdateServiceInput {
 	return domain.UpdateServiceInput{
 		Name:            r.Name,
 		Description:     r.Description,
+		Translations:    toDomainTranslations(r.Translations),
 		AverageDuration: r.AverageDuration,
 		AddressIDs:      r.AddressIDs,
+		IsActive:        r.IsActive,
+		VehicleClasses:  r.VehicleClasses,
+		BasePrice:       r.BasePrice,
+		BaseCurrency:    r.BaseCurrency,
+		ClearBasePrice:  r.ClearBasePrice,
 	}
 }
 
+// ToCreateServiceRequest собирает запрос на создание услуги из шаблона и переопределений
+func (r *CreateFromTemplateRequest) ToCreateServiceRequest(t *domain.ServiceTemplate) *CreateServiceRequest {
+	req := &CreateServiceRequest{
+		Name:            t.Name,
+		Description:     t.Description,
+		AverageDuration: t.DefaultDuration,
+		Translations:    r.Translations,
+		AddressIDs:      r.AddressIDs,
+		IsActive:        r.IsActive,
+		VehicleClasses:  r.VehicleClasses,
+		BasePrice:       r.BasePrice,
+		BaseCurrency:    r.BaseCurrency,
+	}
+
+	if r.Name != nil {
+		req.Name = *r.Name
+	}
+	if r.Description != nil {
+		req.Description = r.Description
+	}
+	if r.AverageDuration != nil {
+		req.AverageDuration = r.AverageDuration
+	}
+
+	return req
+}
+
 // FromDomainService конвертирует domain модель в DTO
 func FromDomainService(s *domain.Service) *ServiceResponse {
+	// Пустой список отдаём как [], а не null
+	vehicleClasses := s.VehicleClasses
+	if vehicleClasses == nil {
+		vehicleClasses = []string{}
+	}
+
 	return &ServiceResponse{
 		ID:              s.ID,
 		CompanyID:       s.CompanyID,
 		Name:            s.Name,
 		Description:     s.Description,
+		Translations:    fromDomainTranslations(s.Translations),
 		AverageDuration: s.AverageDuration,
 		AddressIDs:      s.AddressIDs,
+		IsActive:        s.IsActive,
+		TemplateID:      s.TemplateID,
+		VehicleClasses:  vehicleClasses,
+		BasePrice:       s.BasePrice,
+		BaseCurrency:    s.BaseCurrency,
 		CreatedAt:       s.CreatedAt,
 		UpdatedAt:       s.UpdatedAt,
 		// Price fields will be populated separately when needed
@@ -106,3 +285,92 @@ func (s *ServiceResponse) EnrichWithPrice(price *float64, currency *string, pric
 	s.VehicleClass = vehicleClass
 	s.AppliedMultiplier = appliedMultiplier
 }
+
+// Статусы обогащения ценами
+const (
+	PricingStatusOK       = "ok"        // PriceService ответил
+	PricingStatusDegraded = "degraded"  // PriceService недоступен или не ответил вовремя, цены временно отсутствуют
+	PricingStatusNotFound = "not_found" // Цена для услуги не настроена в PriceService
+	PricingStatusSkipped  = "skipped"   // Цены не запрашивались (скрытая услуга, пустой список или услуга на момент as_of)
+)
+
+// PricingInfo статус обогащения ценами
+// Позволяет отличить отсутствие цены от недоступности PriceService
+type PricingInfo struct {
+	Status            string `json:"status"`
+	CalculatedForUser bool   `json:"calculated_for_user"` // Цены рассчитаны для пользователя из контекста запроса
+	// Цены рассчитаны для класса автомобиля из параметра vehicle_class
+	VehicleClass *string `json:"vehicle_class,omitempty"`
+	// Фильтры и сортировка по цене не применены, так как цены деградировали (только для списка)
+	PriceFiltersIgnored bool `json:"price_filters_ignored,omitempty"`
+}
+
+// Статусы операций пакета
+const (
+	BatchStatusOK         = "ok"
+	BatchStatusFailed     = "failed"
+	BatchStatusRolledBack = "rolled_back"
+	BatchStatusSkipped    = "skipped"
+)
+
+// Коды ошибок операций пакета
+const (
+	BatchErrorInvalidInput    = "invalid_input"
+	BatchErrorNotFound        = "not_found"
+	BatchErrorAddressNotOwned = "address_not_owned"
+	BatchErrorInternal        = "internal"
+)
+
+// BatchServicesRequest запрос на пакетное изменение услуг компании
+type BatchServicesRequest struct {
+	Operations []BatchOperationRequest `json:"operations"`
+}
+
+// BatchOperationRequest одна операция пакета
+type BatchOperationRequest struct {
+	Op        string                `json:"op"`                   // create | update | delete
+	ServiceID *int64                `json:"service_id,omitempty"` // Для update и delete
+	Service   *UpdateServiceRequest `json:"service,omitempty"`    // Данные услуги для create и update
+}
+
+// BatchServicesResponse ответ с результатами пакета
+type BatchServicesResponse struct {
+	Atomic    bool                   `json:"atomic"`
+	Committed bool                   `json:"committed"`
+	Results   []BatchOperationResult `json:"results"`
+}
+
+// BatchOperationResult результат одной операции пакета
+type BatchOperationResult struct {
+	Index     int                  `json:"index"`
+	Op        string               `json:"op"`
+	Status    string               `json:"status"`
+	ServiceID *int64               `json:"service_id,omitempty"`
+	Service   *ServiceResponse     `json:"service,omitempty"`
+	Error     *BatchOperationError `json:"error,omitempty"`
+}
+
+// BatchOperationError ошибка операции пакета
+type BatchOperationError struct {
+	Code       string  `json:"code"`
+	Message    string  `json:"message"`
+	AddressIDs []int64 `json:"address_ids,omitempty"`
+}
+
+// ToCreateServiceRequest конвертирует данные операции create в запрос на создание услуги
+func (r *BatchOperationRequest) ToCreateServiceRequest() *CreateServiceRequest {
+	req := &CreateServiceRequest{
+		Description:     r.Service.Description,
+		Translations:    r.Service.Translations,
+		AverageDuration: r.Service.AverageDuration,
+		AddressIDs:      r.Service.AddressIDs,
+		IsActive:        r.Service.IsActive,
+		VehicleClasses:  r.Service.VehicleClasses,
+		BasePrice:       r.Service.BasePrice,
+		BaseCurrency:    r.Service.BaseCurrency,
+	}
+	if r.Service.Name != nil {
+		req.Name = *r.Service.Name
+	}
+	return req
+}
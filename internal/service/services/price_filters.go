@@ -0,0 +1,89 @@
+package services
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+// Порядок сортировки списка услуг по цене
+const (
+	SortPriceAsc  = "price_asc"
+	SortPriceDesc = "price_desc"
+)
+
+// validatePriceFilters проверяет фильтры и сортировку по цене
+func validatePriceFilters(req *models.ServiceFilterRequest) error {
+	if req.MinPrice != nil && !isValidPrice(*req.MinPrice) {
+		return fmt.Errorf("%w: min_price must be a non-negative number", ErrInvalidInput)
+	}
+	if req.MaxPrice != nil && !isValidPrice(*req.MaxPrice) {
+		return fmt.Errorf("%w: max_price must be a non-negative number", ErrInvalidInput)
+	}
+	if req.MinPrice != nil && req.MaxPrice != nil && *req.MinPrice > *req.MaxPrice {
+		return fmt.Errorf("%w: min_price must not exceed max_price", ErrInvalidInput)
+	}
+	if req.Sort != nil && *req.Sort != SortPriceAsc && *req.Sort != SortPriceDesc {
+		return fmt.Errorf("%w: unknown sort %q, expected %s or %s", ErrInvalidInput, *req.Sort, SortPriceAsc, SortPriceDesc)
+	}
+	return nil
+}
+
+// isValidPrice проверяет, что цена - конечное неотрицательное число
+func isValidPrice(price float64) bool {
+	return price >= 0 && !math.IsInf(price, 0) && !math.IsNaN(price)
+}
+
+// hasPriceFilters проверяет, запрошены ли фильтры или сортировка по цене
+func hasPriceFilters(req *models.ServiceFilterRequest) bool {
+	return req.MinPrice != nil || req.MaxPrice != nil || req.Sort != nil
+}
+
+// applyPriceFilters фильтрует и сортирует обогащённые ценами услуги
+// Услуги без цены не проходят фильтр по диапазону, а при сортировке идут последними в исходном порядке.
+// Возвращает false, если цены деградировали и фильтры не применялись: частичные цены дали бы неверный результат
+func applyPriceFilters(services []models.ServiceResponse, pricing *models.PricingInfo, req *models.ServiceFilterRequest) ([]models.ServiceResponse, bool) {
+	if pricing != nil && pricing.Status == models.PricingStatusDegraded {
+		return services, false
+	}
+
+	if req.MinPrice != nil || req.MaxPrice != nil {
+		filtered := make([]models.ServiceResponse, 0, len(services))
+		for _, svc := range services {
+			if svc.Price == nil {
+				continue
+			}
+			if req.MinPrice != nil && *svc.Price < *req.MinPrice {
+				continue
+			}
+			if req.MaxPrice != nil && *svc.Price > *req.MaxPrice {
+				continue
+			}
+			filtered = append(filtered, svc)
+		}
+		services = filtered
+	}
+
+	if req.Sort != nil {
+		desc := *req.Sort == SortPriceDesc
+		slices.SortStableFunc(services, func(a, b models.ServiceResponse) int {
+			switch {
+			case a.Price == nil && b.Price == nil:
+				return 0
+			case a.Price == nil:
+				return 1
+			case b.Price == nil:
+				return -1
+			case desc:
+				return cmp.Compare(*b.Price, *a.Price)
+			default:
+				return cmp.Compare(*a.Price, *b.Price)
+			}
+		})
+	}
+
+	return services, true
+}
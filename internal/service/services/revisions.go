@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serviceRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/service"
+	"github.com/m04kA/SMK-SellerService/internal/service"
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+// ListRevisions получает историю изменений услуги
+// История доступна менеджерам компании и ролям с правом чтения услуг, в том числе для удалённых услуг
+func (s *Service) ListRevisions(ctx context.Context, companyID int64, serviceID int64, userID int64, userRole string) (*models.ServiceRevisionListResponse, error) {
+	// Проверка прав доступа к компании
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceService, service.ActionRead); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.serviceRepo.ListRevisions(ctx, companyID, serviceID)
+	if err != nil {
+		if errors.Is(err, serviceRepo.ErrServiceNotFound) {
+			return nil, ErrServiceNotFound
+		}
+		return nil, fmt.Errorf("%w: ListRevisions - repository error: %v", ErrInternal, err)
+	}
+
+	return models.FromDomainRevisionList(revisions), nil
+}
+
+// GetAsOf получает услугу в том виде, в каком она была на момент asOf
+// Цены не подставляются: PriceService знает только текущие цены.
+// Услуга, скрытая на тот момент, доступна только менеджерам компании и ролям с правом чтения услуг
+func (s *Service) GetAsOf(ctx context.Context, companyID int64, serviceID int64, asOf time.Time, userID *int64, userRole string, locale string) (*models.ServiceResponse, error) {
+	revision, err := s.serviceRepo.GetAsOf(ctx, companyID, serviceID, asOf)
+	if err != nil {
+		if errors.Is(err, serviceRepo.ErrRevisionNotFound) {
+			return nil, ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("%w: GetAsOf - repository error: %v", ErrInternal, err)
+	}
+
+	if !revision.Service.IsActive {
+		canViewHidden, err := s.canViewHidden(ctx, companyID, userID, userRole)
+		if err != nil {
+			return nil, err
+		}
+		if !canViewHidden {
+			return nil, ErrRevisionNotFound
+		}
+	}
+
+	localizeService(&revision.Service, locale)
+	response := models.FromDomainRevision(revision)
+	// Исторических цен нет: PriceService знает только текущие цены
+	response.Pricing = &models.PricingInfo{Status: models.PricingStatusSkipped}
+	return response, nil
+}
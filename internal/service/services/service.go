@@ -5,37 +5,94 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/m04kA/SMK-SellerService/internal/domain"
 	"github.com/m04kA/SMK-SellerService/internal/service"
 	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
 	companyRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/company"
 	serviceRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/service"
+	templateRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/template"
 	"github.com/m04kA/SMK-SellerService/internal/integrations/priceservice"
 )
 
 type Service struct {
-	serviceRepo ServiceRepository
-	companyRepo CompanyRepository
-	priceClient PriceServiceClient
+	serviceRepo  ServiceRepository
+	companyRepo  CompanyRepository
+	templateRepo TemplateRepository
+	bayRepo      BayRepository
+	priceClient  PriceServiceClient
+	priceCache   PriceCache
 }
 
-func NewService(serviceRepo ServiceRepository, companyRepo CompanyRepository, priceClient PriceServiceClient) *Service {
+func NewService(serviceRepo ServiceRepository, companyRepo CompanyRepository, templateRepo TemplateRepository, bayRepo BayRepository, priceClient PriceServiceClient, priceCache PriceCache) *Service {
 	return &Service{
-		serviceRepo: serviceRepo,
-		companyRepo: companyRepo,
-		priceClient: priceClient,
+		serviceRepo:  serviceRepo,
+		companyRepo:  companyRepo,
+		templateRepo: templateRepo,
+		bayRepo:      bayRepo,
+		priceClient:  priceClient,
+		priceCache:   priceCache,
 	}
 }
 
 // Create создает новую услугу для компании
 func (s *Service) Create(ctx context.Context, companyID int64, userID int64, userRole string, req *models.CreateServiceRequest) (*models.ServiceResponse, error) {
 	// Проверка прав доступа к компании
-	if err := s.checkAccess(ctx, companyID, userID, userRole); err != nil {
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceService, service.ActionCreate); err != nil {
 		return nil, err
 	}
 
 	input := req.ToDomainCreateInput()
+	return s.create(ctx, companyID, input)
+}
+
+// CreateFromTemplate создает услугу компании на основе шаблона из глобального каталога
+// Поля шаблона можно переопределить в запросе, услуга запоминает template_id
+func (s *Service) CreateFromTemplate(ctx context.Context, companyID int64, templateID int64, userID int64, userRole string, req *models.CreateFromTemplateRequest) (*models.ServiceResponse, error) {
+	// Проверка прав доступа к компании
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceService, service.ActionCreate); err != nil {
+		return nil, err
+	}
+
+	template, err := s.templateRepo.GetByID(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, templateRepo.ErrTemplateNotFound) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("%w: CreateFromTemplate - template repository error: %v", ErrInternal, err)
+	}
+
+	input := req.ToCreateServiceRequest(template).ToDomainCreateInput()
+	input.TemplateID = &template.ID
+
+	return s.create(ctx, companyID, input)
+}
+
+// create сохраняет услугу после проверки прав доступа
+func (s *Service) create(ctx context.Context, companyID int64, input domain.CreateServiceInput) (*models.ServiceResponse, error) {
+	vehicleClasses, err := normalizeVehicleClasses(input.VehicleClasses)
+	if err != nil {
+		return nil, err
+	}
+	input.VehicleClasses = vehicleClasses
+	if err := validateTranslations(input.Translations); err != nil {
+		return nil, err
+	}
+	baseCurrency, err := validateBasePrice(input.BasePrice, input.BaseCurrency)
+	if err != nil {
+		return nil, err
+	}
+	input.BaseCurrency = baseCurrency
+	if input.BasePrice != nil && input.BaseCurrency == nil {
+		defaultCurrency := domain.DefaultCurrency
+		input.BaseCurrency = &defaultCurrency
+	}
+
 	service, err := s.serviceRepo.Create(ctx, companyID, input)
 	if err != nil {
+		var ownershipErr *serviceRepo.AddressOwnershipError
+		if errors.As(err, &ownershipErr) {
+			return nil, &AddressOwnershipError{AddressIDs: ownershipErr.AddressIDs}
+		}
 		return nil, fmt.Errorf("%w: Create - repository error: %v", ErrInternal, err)
 	}
 
@@ -43,7 +100,16 @@ func (s *Service) Create(ctx context.Context, companyID int64, userID int64, use
 }
 
 // GetByID получает услугу по ID с опциональным обогащением ценами
-func (s *Service) GetByID(ctx context.Context, companyID int64, serviceID int64, userID *int64) (*models.ServiceResponse, error) {
+// Скрытые услуги доступны только менеджерам компании и ролям с правом чтения услуг.
+// vehicleClass - явный класс автомобиля для расчёта цены (например, для гостя), nil - класс определяет PriceService.
+// Название и описание возвращаются на языке locale, если есть перевод
+func (s *Service) GetByID(ctx context.Context, companyID int64, serviceID int64, userID *int64, userRole string, vehicleClass *string, locale string) (*models.ServiceResponse, error) {
+	if vehicleClass != nil {
+		if err := validateVehicleClass(*vehicleClass); err != nil {
+			return nil, err
+		}
+	}
+
 	service, err := s.serviceRepo.GetByID(ctx, companyID, serviceID)
 	if err != nil {
 		if errors.Is(err, serviceRepo.ErrServiceNotFound) {
@@ -52,12 +118,24 @@ func (s *Service) GetByID(ctx context.Context, companyID int64, serviceID int64,
 		return nil, fmt.Errorf("%w: GetByID - repository error: %v", ErrInternal, err)
 	}
 
+	if !service.IsActive {
+		canViewHidden, err := s.canViewHidden(ctx, companyID, userID, userRole)
+		if err != nil {
+			return nil, err
+		}
+		// Для публичного каталога скрытая услуга не существует
+		if !canViewHidden {
+			return nil, ErrServiceNotFound
+		}
+	}
+
+	localizeService(service, locale)
 	serviceDTO := models.FromDomainService(service)
 
 	// Обогащаем ценами через PriceService
 	servicePtrs := []*models.ServiceResponse{serviceDTO}
-	// Graceful degradation: при ошибке PriceService возвращаем данные без цен
-	s.enrichWithPrices(ctx, companyID, userID, servicePtrs)
+	// Graceful degradation: при ошибке PriceService возвращаем данные без цен и статус degraded
+	s.enrichWithPrices(ctx, companyID, userID, vehicleClass, servicePtrs)
 
 	// Проверка на всякий случай (не должно произойти, но для безопасности)
 	if len(servicePtrs) == 0 {
@@ -68,12 +146,37 @@ func (s *Service) GetByID(ctx context.Context, companyID int64, serviceID int64,
 }
 
 // ListByCompany получает список услуг компании с опциональным обогащением ценами
-func (s *Service) ListByCompany(ctx context.Context, companyID int64, userID *int64) (*models.ServiceListResponse, error) {
-	services, err := s.serviceRepo.ListByCompany(ctx, companyID)
+// Менеджеры компании и роли с правом чтения услуг видят также скрытые услуги.
+// Если класс автомобиля пользователя известен (из фильтра или от PriceService), неподходящие услуги не возвращаются.
+// Класс из фильтра также передаётся в PriceService, и цены рассчитываются для него.
+// Фильтры min_price/max_price и сортировка по цене применяются после обогащения и игнорируются при деградации цен.
+// Название и описание возвращаются на языке locale, если есть перевод
+func (s *Service) ListByCompany(ctx context.Context, companyID int64, userID *int64, userRole string, req *models.ServiceFilterRequest, locale string) (*models.ServiceListResponse, error) {
+	if req.VehicleClass != nil {
+		if err := validateVehicleClass(*req.VehicleClass); err != nil {
+			return nil, err
+		}
+	}
+	if err := validatePriceFilters(req); err != nil {
+		return nil, err
+	}
+
+	canViewHidden, err := s.canViewHidden(ctx, companyID, userID, userRole)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := req.ToDomainFilter()
+	filter.OnlyActive = !canViewHidden
+
+	services, err := s.serviceRepo.ListByCompany(ctx, companyID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("%w: ListByCompany - repository error: %v", ErrInternal, err)
 	}
 
+	for i := range services {
+		localizeService(&services[i], locale)
+	}
 	listResponse := models.FromDomainServiceList(services)
 
 	// Обогащаем ценами через PriceService
@@ -83,8 +186,8 @@ func (s *Service) ListByCompany(ctx context.Context, companyID int64, userID *in
 		for i := range listResponse.Services {
 			servicePtrs[i] = &listResponse.Services[i]
 		}
-		// Graceful degradation: при ошибке PriceService возвращаем данные без цен
-		s.enrichWithPrices(ctx, companyID, userID, servicePtrs)
+		// Graceful degradation: при ошибке PriceService возвращаем данные без цен и статус degraded
+		listResponse.Pricing = s.enrichWithPrices(ctx, companyID, userID, req.VehicleClass, servicePtrs)
 
 		// Обновляем список услуг из обогащённых указателей
 		for i, svcPtr := range servicePtrs {
@@ -92,6 +195,23 @@ func (s *Service) ListByCompany(ctx context.Context, companyID int64, userID *in
 				listResponse.Services[i] = *svcPtr
 			}
 		}
+
+		// Класс автомобиля из PriceService скрывает неподходящие услуги в каталоге,
+		// менеджеры компании видят полный список
+		if req.VehicleClass == nil && !canViewHidden {
+			if class := callerVehicleClass(listResponse.Services); class != nil {
+				listResponse.Services = omitInapplicable(listResponse.Services, *class)
+			}
+		}
+	} else {
+		listResponse.Pricing = &models.PricingInfo{Status: models.PricingStatusSkipped}
+	}
+
+	// Фильтры по цене возможны только после обогащения
+	if hasPriceFilters(req) {
+		services, applied := applyPriceFilters(listResponse.Services, listResponse.Pricing, req)
+		listResponse.Services = services
+		listResponse.Pricing.PriceFiltersIgnored = !applied
 	}
 
 	return listResponse, nil
@@ -100,26 +220,43 @@ func (s *Service) ListByCompany(ctx context.Context, companyID int64, userID *in
 // Update обновляет услугу
 func (s *Service) Update(ctx context.Context, companyID int64, serviceID int64, userID int64, userRole string, req *models.UpdateServiceRequest) (*models.ServiceResponse, error) {
 	// Проверка прав доступа к компании
-	if err := s.checkAccess(ctx, companyID, userID, userRole); err != nil {
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceService, service.ActionUpdate); err != nil {
 		return nil, err
 	}
 
 	input := req.ToDomainUpdateInput()
+	vehicleClasses, err := normalizeVehicleClasses(input.VehicleClasses)
+	if err != nil {
+		return nil, err
+	}
+	input.VehicleClasses = vehicleClasses
+	if err := validateTranslations(input.Translations); err != nil {
+		return nil, err
+	}
+	if err := validateBasePriceUpdate(&input); err != nil {
+		return nil, err
+	}
+
 	service, err := s.serviceRepo.Update(ctx, companyID, serviceID, input)
 	if err != nil {
 		if errors.Is(err, serviceRepo.ErrServiceNotFound) {
 			return nil, ErrServiceNotFound
 		}
+		var ownershipErr *serviceRepo.AddressOwnershipError
+		if errors.As(err, &ownershipErr) {
+			return nil, &AddressOwnershipError{AddressIDs: ownershipErr.AddressIDs}
+		}
 		return nil, fmt.Errorf("%w: Update - repository error: %v", ErrInternal, err)
 	}
 
+	s.priceCache.InvalidateService(serviceID)
 	return models.FromDomainService(service), nil
 }
 
 // Delete удаляет услугу
 func (s *Service) Delete(ctx context.Context, companyID int64, serviceID int64, userID int64, userRole string) error {
 	// Проверка прав доступа к компании
-	if err := s.checkAccess(ctx, companyID, userID, userRole); err != nil {
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceService, service.ActionDelete); err != nil {
 		return err
 	}
 
@@ -130,34 +267,51 @@ func (s *Service) Delete(ctx context.Context, companyID int64, serviceID int64,
 		return fmt.Errorf("%w: Delete - repository error: %v", ErrInternal, err)
 	}
 
+	s.priceCache.InvalidateService(serviceID)
 	return nil
 }
 
-// enrichWithPrices обогащает услуги ценами через PriceService
-// При ошибке применяется graceful degradation - услуги возвращаются без цен
-func (s *Service) enrichWithPrices(ctx context.Context, companyID int64, userID *int64, services []*models.ServiceResponse) {
-	if len(services) == 0 {
-		return
+// enrichWithPrices обогащает услуги ценами через PriceService и возвращает общий статус обогащения
+// При ошибке применяется graceful degradation - услуги возвращаются без цен со статусом degraded
+// Скрытые услуги не обогащаются: их цены не нужны в каталоге
+// vehicleClass переопределяет класс автомобиля пользователя при расчёте
+func (s *Service) enrichWithPrices(ctx context.Context, companyID int64, userID *int64, vehicleClass *string, services []*models.ServiceResponse) *models.PricingInfo {
+	// Собираем ID активных услуг
+	serviceIDs := make([]int64, 0, len(services))
+	for _, svc := range services {
+		if svc.IsActive {
+			serviceIDs = append(serviceIDs, svc.ID)
+		} else {
+			svc.Pricing = &models.PricingInfo{Status: models.PricingStatusSkipped}
+		}
 	}
 
-	// Собираем ID услуг
-	serviceIDs := make([]int64, len(services))
-	for i, svc := range services {
-		serviceIDs[i] = svc.ID
+	if len(serviceIDs) == 0 {
+		return &models.PricingInfo{Status: models.PricingStatusSkipped}
 	}
 
 	// Запрашиваем цены из PriceService
 	pricesReq := &priceservice.CalculatePricesRequest{
-		CompanyID:  companyID,
-		UserID:     userID,
-		ServiceIDs: serviceIDs,
+		CompanyID:    companyID,
+		UserID:       userID,
+		VehicleClass: vehicleClass,
+		ServiceIDs:   serviceIDs,
 	}
 
 	pricesResp, err := s.priceClient.CalculatePricesWithGracefulDegradation(ctx, pricesReq)
 	if err != nil {
-		// Graceful degradation: если PriceService недоступен, просто не добавляем цены
-		// Ошибка уже залогирована в клиенте PriceService
-		return
+		// Graceful degradation: PriceService недоступен и базовых цен нет - просто не добавляем цены
+		// Ошибка PriceService уже залогирована в клиенте
+		status := models.PricingStatusDegraded
+		if errors.Is(err, priceservice.ErrPricesNotFound) {
+			status = models.PricingStatusNotFound
+		}
+		for _, svc := range services {
+			if svc.IsActive {
+				svc.Pricing = &models.PricingInfo{Status: status}
+			}
+		}
+		return &models.PricingInfo{Status: status}
 	}
 
 	// Создаём map для быстрого поиска цен по service_id
@@ -167,38 +321,70 @@ func (s *Service) enrichWithPrices(ctx context.Context, companyID int64, userID
 	}
 
 	// Обогащаем услуги ценами
+	// Degraded: PriceService недоступен, часть цен может быть из кэша или базовых цен услуг
+	// С явным классом цена рассчитана для класса, а не для автомобиля пользователя
+	forUser := userID != nil && vehicleClass == nil
+	missingStatus := models.PricingStatusNotFound
+	if pricesResp.Degraded {
+		missingStatus = models.PricingStatusDegraded
+	}
 	for _, svc := range services {
-		if price, ok := priceMap[svc.ID]; ok {
-			svc.EnrichWithPrice(
-				price.Price,
-				price.Currency,
-				price.PricingType,
-				price.VehicleClass,
-				price.AppliedMultiplier,
-			)
+		if !svc.IsActive {
+			continue
+		}
+		price, ok := priceMap[svc.ID]
+		if !ok {
+			svc.Pricing = &models.PricingInfo{Status: missingStatus}
+			continue
+		}
+		svc.EnrichWithPrice(
+			price.Price,
+			price.Currency,
+			price.PricingType,
+			price.VehicleClass,
+			price.AppliedMultiplier,
+		)
+		// Базовая цена - это "от N", а не цена PriceService
+		if price.PricingType != nil && *price.PricingType == priceservice.PricingTypeFallbackBase {
+			svc.Pricing = &models.PricingInfo{Status: missingStatus}
+			continue
 		}
+		svc.Pricing = &models.PricingInfo{Status: models.PricingStatusOK, CalculatedForUser: forUser, VehicleClass: vehicleClass}
 	}
-}
 
-// checkAccess проверяет права доступа пользователя к компании
-func (s *Service) checkAccess(ctx context.Context, companyID int64, userID int64, userRole string) error {
-	// Superuser имеет полный доступ
-	if userRole == service.RoleSuperuser {
-		return nil
+	if pricesResp.Degraded {
+		return &models.PricingInfo{Status: models.PricingStatusDegraded}
 	}
+	return &models.PricingInfo{Status: models.PricingStatusOK, CalculatedForUser: forUser, VehicleClass: vehicleClass}
+}
 
-	// Обычный пользователь должен быть менеджером компании
-	isManager, err := s.companyRepo.IsManager(ctx, companyID, userID)
-	if err != nil {
-		if errors.Is(err, companyRepo.ErrCompanyNotFound) {
-			return ErrCompanyNotFound
-		}
-		return fmt.Errorf("%w: checkAccess - repository error: %v", ErrInternal, err)
+// canViewHidden проверяет, может ли пользователь видеть скрытые услуги компании
+// Анонимный пользователь, не-менеджер и несуществующая компания - это просто публичный просмотр
+func (s *Service) canViewHidden(ctx context.Context, companyID int64, userID *int64, userRole string) (bool, error) {
+	if userID == nil {
+		return false, nil
 	}
 
-	if !isManager {
-		return ErrAccessDenied
+	err := s.checkAccess(ctx, companyID, *userID, userRole, service.ResourceService, service.ActionRead)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, ErrAccessDenied) || errors.Is(err, ErrCompanyNotFound) {
+		return false, nil
 	}
 
-	return nil
+	return false, err
+}
+
+// checkAccess проверяет по матрице прав, может ли пользователь выполнить действия над ресурсом компании
+// Решение принимает service.CheckAccess, здесь только ошибки репозитория приводятся к ошибкам сервиса
+func (s *Service) checkAccess(ctx context.Context, companyID int64, userID int64, userRole string, resource service.Resource, actions ...service.Action) error {
+	err := service.CheckAccess(ctx, s.companyRepo, companyID, userID, userRole, resource, actions...)
+	if err == nil || errors.Is(err, ErrAccessDenied) {
+		return err
+	}
+	if errors.Is(err, companyRepo.ErrCompanyNotFound) {
+		return ErrCompanyNotFound
+	}
+	return fmt.Errorf("%w: checkAccess - repository error: %v", ErrInternal, err)
 }
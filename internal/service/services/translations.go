@@ -0,0 +1,24 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+)
+
+// validateTranslations проверяет языки и названия в переводах услуги
+func validateTranslations(translations domain.Translations) error {
+	if err := translations.Validate(); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return nil
+}
+
+// localizeService подставляет название и описание услуги на языке locale
+// Пустой locale означает базовый текст
+func localizeService(service *domain.Service, locale string) {
+	if locale == "" {
+		return
+	}
+	service.Name, service.Description = service.Translations.Localize(domain.Locale(locale), service.Name, service.Description)
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/m04kA/SMK-SellerService/internal/service/services/models"
+)
+
+// vehicleClasses допустимые классы автомобилей (европейская классификация, как в PriceService)
+var vehicleClasses = map[string]struct{}{
+	"A": {}, "B": {}, "C": {}, "D": {}, "E": {}, "F": {}, "J": {}, "M": {}, "S": {},
+}
+
+// validateVehicleClass проверяет, что класс автомобиля известен
+func validateVehicleClass(class string) error {
+	if _, ok := vehicleClasses[class]; !ok {
+		return fmt.Errorf("%w: unknown vehicle class %q", ErrInvalidInput, class)
+	}
+	return nil
+}
+
+// normalizeVehicleClasses проверяет классы и убирает дубликаты с сохранением порядка
+// nil остаётся nil, чтобы при обновлении не затирать сохранённый список
+func normalizeVehicleClasses(classes []string) ([]string, error) {
+	if classes == nil {
+		return nil, nil
+	}
+
+	seen := make(map[string]struct{}, len(classes))
+	normalized := make([]string, 0, len(classes))
+	for _, class := range classes {
+		if err := validateVehicleClass(class); err != nil {
+			return nil, err
+		}
+		if _, ok := seen[class]; ok {
+			continue
+		}
+		seen[class] = struct{}{}
+		normalized = append(normalized, class)
+	}
+
+	return normalized, nil
+}
+
+// isApplicable проверяет, подходит ли услуга для класса автомобиля
+func isApplicable(svc *models.ServiceResponse, class string) bool {
+	if len(svc.VehicleClasses) == 0 {
+		return true
+	}
+	for _, c := range svc.VehicleClasses {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// callerVehicleClass возвращает класс автомобиля пользователя, определённый PriceService при расчёте цен
+func callerVehicleClass(services []models.ServiceResponse) *string {
+	for i := range services {
+		if services[i].VehicleClass != nil {
+			return services[i].VehicleClass
+		}
+	}
+	return nil
+}
+
+// omitInapplicable убирает из списка услуги, которые не оказываются для класса автомобиля
+func omitInapplicable(services []models.ServiceResponse, class string) []models.ServiceResponse {
+	applicable := make([]models.ServiceResponse, 0, len(services))
+	for i := range services {
+		if isApplicable(&services[i], class) {
+			applicable = append(applicable, services[i])
+		}
+	}
+	return applicable
+}
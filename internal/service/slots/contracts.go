@@ -0,0 +1,29 @@
+package slots
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+)
+
+// CompanyRepository интерфейс для чтения расписания и адресов компании
+type CompanyRepository interface {
+	IsManager(ctx context.Context, companyID int64, userID int64) (bool, error)
+	GetByID(ctx context.Context, id int64) (*domain.Company, error)
+}
+
+// ServiceRepository интерфейс для чтения услуг компании
+type ServiceRepository interface {
+	GetByID(ctx context.Context, companyID int64, serviceID int64) (*domain.Service, error)
+}
+
+// ScheduleRepository интерфейс репозитория занятости адресов
+type ScheduleRepository interface {
+	ListBusyIntervals(ctx context.Context, addressID int64, date string) ([]domain.BusyInterval, error)
+	ReplaceBusyIntervals(ctx context.Context, addressID int64, date string, intervals []domain.BusyInterval) error
+}
+
+// BayRepository интерфейс для подсчёта постов адреса
+type BayRepository interface {
+	CountForService(ctx context.Context, addressID int64, serviceID int64) (total int, capable int, err error)
+}
@@ -0,0 +1,33 @@
+package slots
+
+import (
+	"errors"
+
+	"github.com/m04kA/SMK-SellerService/internal/service"
+)
+
+var (
+	// ErrCompanyNotFound возвращается, когда компания не найдена
+	ErrCompanyNotFound = errors.New("company not found")
+
+	// ErrAddressNotFound возвращается, когда адрес не найден у компании
+	ErrAddressNotFound = errors.New("address not found")
+
+	// ErrServiceNotFound возвращается, когда услуга не найдена или скрыта
+	ErrServiceNotFound = errors.New("service not found")
+
+	// ErrServiceNotAtAddress возвращается, когда услуга не оказывается на адресе
+	ErrServiceNotAtAddress = errors.New("service is not available at this address")
+
+	// ErrDurationUnknown возвращается, когда у услуги не задана средняя длительность
+	ErrDurationUnknown = errors.New("service has no average duration")
+
+	// ErrAccessDenied возвращается, когда матрица прав не разрешает действие с компанией
+	ErrAccessDenied = service.ErrAccessDenied
+
+	// ErrInvalidInput возвращается при некорректных входных данных
+	ErrInvalidInput = errors.New("invalid input data")
+
+	// ErrInternal возвращается при внутренних ошибках сервиса
+	ErrInternal = errors.New("service: internal error")
+)
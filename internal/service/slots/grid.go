@@ -0,0 +1,197 @@
+package slots
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	"github.com/m04kA/SMK-SellerService/internal/service/slots/models"
+)
+
+const (
+	dateLayout    = "2006-01-02"
+	minutesPerDay = 24 * 60
+)
+
+// interval интервал в минутах от начала дня, конец не включается
+type interval struct {
+	start int
+	end   int
+}
+
+// parseClock разбирает время "HH:MM" (или "HH:MM:SS" из БД) в минуты от начала дня
+func parseClock(value string) (int, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("%w: invalid time %q, expected HH:MM", ErrInvalidInput, value)
+	}
+
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil || hours < 0 || hours > 24 {
+		return 0, fmt.Errorf("%w: invalid time %q, expected HH:MM", ErrInvalidInput, value)
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("%w: invalid time %q, expected HH:MM", ErrInvalidInput, value)
+	}
+
+	total := hours*60 + minutes
+	if total > minutesPerDay {
+		return 0, fmt.Errorf("%w: invalid time %q, expected HH:MM", ErrInvalidInput, value)
+	}
+
+	return total, nil
+}
+
+// formatClock форматирует минуты от начала дня в "HH:MM"
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+// parseDate разбирает дату "YYYY-MM-DD"
+func parseDate(value string) (time.Time, error) {
+	date, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid date %q, expected YYYY-MM-DD", ErrInvalidInput, value)
+	}
+	return date, nil
+}
+
+// parseIntervals проверяет интервалы из запроса и конвертирует их в domain модели
+func parseIntervals(requests []models.IntervalRequest) ([]domain.BusyInterval, error) {
+	intervals := make([]domain.BusyInterval, 0, len(requests))
+	for _, req := range requests {
+		start, err := parseClock(req.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClock(req.End)
+		if err != nil {
+			return nil, err
+		}
+		if end <= start {
+			return nil, fmt.Errorf("%w: interval %s-%s must end after it starts", ErrInvalidInput, req.Start, req.End)
+		}
+
+		intervals = append(intervals, domain.BusyInterval{
+			Start: domain.TimeString(formatClock(start)),
+			End:   domain.TimeString(formatClock(end)),
+		})
+	}
+	return intervals, nil
+}
+
+// toMinuteIntervals конвертирует domain интервалы в минуты от начала дня
+func toMinuteIntervals(busy []domain.BusyInterval) ([]interval, error) {
+	intervals := make([]interval, 0, len(busy))
+	for _, b := range busy {
+		start, err := parseClock(string(b.Start))
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseClock(string(b.End))
+		if err != nil {
+			return nil, err
+		}
+		intervals = append(intervals, interval{start: start, end: end})
+	}
+	return intervals, nil
+}
+
+// daySchedule возвращает расписание компании на день недели
+func daySchedule(wh domain.WorkingHours, weekday time.Weekday) domain.DaySchedule {
+	switch weekday {
+	case time.Monday:
+		return wh.Monday
+	case time.Tuesday:
+		return wh.Tuesday
+	case time.Wednesday:
+		return wh.Wednesday
+	case time.Thursday:
+		return wh.Thursday
+	case time.Friday:
+		return wh.Friday
+	case time.Saturday:
+		return wh.Saturday
+	default:
+		return wh.Sunday
+	}
+}
+
+// openingInterval возвращает рабочий интервал дня; ok=false, если в этот день компания не работает
+// Закрытие в "00:00" означает работу до полуночи, ночные смены обрезаются концом дня
+func openingInterval(schedule domain.DaySchedule) (interval, bool, error) {
+	if !schedule.IsOpen || schedule.OpenTime == nil || schedule.CloseTime == nil {
+		return interval{}, false, nil
+	}
+
+	open, err := parseClock(string(*schedule.OpenTime))
+	if err != nil {
+		return interval{}, false, err
+	}
+	closeAt, err := parseClock(string(*schedule.CloseTime))
+	if err != nil {
+		return interval{}, false, err
+	}
+	if closeAt <= open {
+		closeAt = minutesPerDay
+	}
+
+	return interval{start: open, end: closeAt}, true, nil
+}
+
+// buildGrid строит сетку слотов с шагом step внутри рабочего интервала
+// Слот попадает в сетку, если на всём его протяжении занято меньше постов, чем capacity
+func buildGrid(opening interval, duration int, step int, capacity int, busy []interval) []models.SlotResponse {
+	slots := make([]models.SlotResponse, 0)
+	for start := opening.start; start+duration <= opening.end; start += step {
+		end := start + duration
+		available := capacity - maxOverlap(busy, start, end)
+		if available <= 0 {
+			continue
+		}
+
+		slots = append(slots, models.SlotResponse{
+			Start:     formatClock(start),
+			End:       formatClock(end),
+			Available: available,
+		})
+	}
+	return slots
+}
+
+// maxOverlap считает максимальное число одновременно занятых постов внутри [start, end)
+func maxOverlap(busy []interval, start int, end int) int {
+	type event struct {
+		at    int
+		delta int
+	}
+
+	events := make([]event, 0, len(busy)*2)
+	for _, b := range busy {
+		if b.end <= start || b.start >= end {
+			continue
+		}
+		events = append(events, event{at: max(b.start, start), delta: 1}, event{at: min(b.end, end), delta: -1})
+	}
+
+	// При совпадении времени освобождение поста обрабатывается раньше занятия
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].at != events[j].at {
+			return events[i].at < events[j].at
+		}
+		return events[i].delta < events[j].delta
+	})
+
+	current, peak := 0, 0
+	for _, e := range events {
+		current += e.delta
+		if current > peak {
+			peak = current
+		}
+	}
+	return peak
+}
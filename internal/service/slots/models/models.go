@@ -0,0 +1,72 @@
+package models
+
+import "github.com/m04kA/SMK-SellerService/internal/domain"
+
+// SlotsRequest запрос на расчёт сетки слотов
+type SlotsRequest struct {
+	ServiceID int64             `json:"service_id"`
+	Date      string            `json:"date"`           // Формат "YYYY-MM-DD"
+	Busy      []IntervalRequest `json:"busy,omitempty"` // Занятость, переданная вызывающей стороной
+}
+
+// BusyIntervalsRequest запрос на замену занятости адреса на дату
+type BusyIntervalsRequest struct {
+	Date      string            `json:"date"` // Формат "YYYY-MM-DD"
+	Intervals []IntervalRequest `json:"intervals"`
+}
+
+// IntervalRequest интервал времени, каждый интервал занимает один пост
+type IntervalRequest struct {
+	Start string `json:"start"` // Формат "HH:MM"
+	End   string `json:"end"`   // Формат "HH:MM"
+}
+
+// SlotsResponse ответ с сеткой слотов
+type SlotsResponse struct {
+	CompanyID       int64          `json:"company_id"`
+	AddressID       int64          `json:"address_id"`
+	ServiceID       int64          `json:"service_id"`
+	Date            string         `json:"date"`
+	DurationMinutes int            `json:"duration_minutes"`
+	StepMinutes     int            `json:"step_minutes"`
+	Capacity        int            `json:"capacity"`
+	Slots           []SlotResponse `json:"slots"`
+}
+
+// SlotResponse свободный слот: время начала и окончания услуги
+type SlotResponse struct {
+	Start     string `json:"start"`
+	End       string `json:"end"`
+	Available int    `json:"available"` // Количество свободных постов на весь интервал
+}
+
+// BusyIntervalsResponse ответ с сохранённой занятостью адреса
+type BusyIntervalsResponse struct {
+	AddressID int64              `json:"address_id"`
+	Date      string             `json:"date"`
+	Intervals []IntervalResponse `json:"intervals"`
+}
+
+// IntervalResponse интервал времени
+type IntervalResponse struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// FromDomainBusyIntervals конвертирует domain модели в DTO
+func FromDomainBusyIntervals(addressID int64, date string, intervals []domain.BusyInterval) *BusyIntervalsResponse {
+	response := &BusyIntervalsResponse{
+		AddressID: addressID,
+		Date:      date,
+		Intervals: make([]IntervalResponse, len(intervals)),
+	}
+
+	for i, interval := range intervals {
+		response.Intervals[i] = IntervalResponse{
+			Start: string(interval.Start),
+			End:   string(interval.End),
+		}
+	}
+
+	return response
+}
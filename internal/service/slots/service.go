@@ -0,0 +1,186 @@
+package slots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+	companyRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/company"
+	serviceRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/service"
+	"github.com/m04kA/SMK-SellerService/internal/service"
+	"github.com/m04kA/SMK-SellerService/internal/service/slots/models"
+)
+
+type Service struct {
+	companyRepo  CompanyRepository
+	serviceRepo  ServiceRepository
+	scheduleRepo ScheduleRepository
+	bayRepo      BayRepository
+	stepMinutes  int
+}
+
+func NewService(companyRepo CompanyRepository, serviceRepo ServiceRepository, scheduleRepo ScheduleRepository, bayRepo BayRepository, stepMinutes int) *Service {
+	return &Service{
+		companyRepo:  companyRepo,
+		serviceRepo:  serviceRepo,
+		scheduleRepo: scheduleRepo,
+		bayRepo:      bayRepo,
+		stepMinutes:  stepMinutes,
+	}
+}
+
+// GetSlots рассчитывает возможные времена начала услуги на адресе в указанную дату
+// Учитываются рабочие часы компании, длительность услуги, вместимость адреса
+// (число постов, умеющих выполнять услугу, если посты заведены), сохранённая занятость и занятость, переданная в запросе
+func (s *Service) GetSlots(ctx context.Context, companyID int64, addressID int64, req *models.SlotsRequest) (*models.SlotsResponse, error) {
+	date, err := parseDate(req.Date)
+	if err != nil {
+		return nil, err
+	}
+	callerBusy, err := parseIntervals(req.Busy)
+	if err != nil {
+		return nil, err
+	}
+
+	company, address, err := s.getAddress(ctx, companyID, addressID)
+	if err != nil {
+		return nil, err
+	}
+
+	svc, err := s.serviceRepo.GetByID(ctx, companyID, req.ServiceID)
+	if err != nil {
+		if errors.Is(err, serviceRepo.ErrServiceNotFound) {
+			return nil, ErrServiceNotFound
+		}
+		return nil, fmt.Errorf("%w: GetSlots - service repository error: %v", ErrInternal, err)
+	}
+	// Скрытая услуга недоступна для записи
+	if !svc.IsActive {
+		return nil, ErrServiceNotFound
+	}
+	if !containsID(svc.AddressIDs, addressID) {
+		return nil, ErrServiceNotAtAddress
+	}
+	if svc.AverageDuration == nil || *svc.AverageDuration <= 0 {
+		return nil, ErrDurationUnknown
+	}
+
+	capacity, err := s.serviceCapacity(ctx, address, svc.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.SlotsResponse{
+		CompanyID:       companyID,
+		AddressID:       addressID,
+		ServiceID:       svc.ID,
+		Date:            req.Date,
+		DurationMinutes: *svc.AverageDuration,
+		StepMinutes:     s.stepMinutes,
+		Capacity:        capacity,
+		Slots:           []models.SlotResponse{},
+	}
+
+	opening, isOpen, err := openingInterval(daySchedule(company.WorkingHours, date.Weekday()))
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetSlots - invalid working hours: %v", ErrInternal, err)
+	}
+	if !isOpen {
+		return response, nil
+	}
+
+	storedBusy, err := s.scheduleRepo.ListBusyIntervals(ctx, addressID, req.Date)
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetSlots - schedule repository error: %v", ErrInternal, err)
+	}
+
+	busy, err := toMinuteIntervals(append(storedBusy, callerBusy...))
+	if err != nil {
+		return nil, fmt.Errorf("%w: GetSlots - invalid busy interval: %v", ErrInternal, err)
+	}
+
+	response.Slots = buildGrid(opening, *svc.AverageDuration, s.stepMinutes, capacity, busy)
+	return response, nil
+}
+
+// ReplaceBusyIntervals заменяет занятость адреса на дату (используется сервисом бронирования)
+func (s *Service) ReplaceBusyIntervals(ctx context.Context, companyID int64, addressID int64, userID int64, userRole string, req *models.BusyIntervalsRequest) (*models.BusyIntervalsResponse, error) {
+	// Проверка прав доступа к компании
+	if err := s.checkAccess(ctx, companyID, userID, userRole, service.ResourceSchedule, service.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	if _, err := parseDate(req.Date); err != nil {
+		return nil, err
+	}
+	intervals, err := parseIntervals(req.Intervals)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err := s.getAddress(ctx, companyID, addressID); err != nil {
+		return nil, err
+	}
+
+	if err := s.scheduleRepo.ReplaceBusyIntervals(ctx, addressID, req.Date, intervals); err != nil {
+		return nil, fmt.Errorf("%w: ReplaceBusyIntervals - schedule repository error: %v", ErrInternal, err)
+	}
+
+	return models.FromDomainBusyIntervals(addressID, req.Date, intervals), nil
+}
+
+// getAddress загружает компанию и находит в ней адрес
+func (s *Service) getAddress(ctx context.Context, companyID int64, addressID int64) (*domain.Company, *domain.Address, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		if errors.Is(err, companyRepo.ErrCompanyNotFound) {
+			return nil, nil, ErrCompanyNotFound
+		}
+		return nil, nil, fmt.Errorf("%w: getAddress - company repository error: %v", ErrInternal, err)
+	}
+
+	for i := range company.Addresses {
+		if company.Addresses[i].ID == addressID {
+			return company, &company.Addresses[i], nil
+		}
+	}
+
+	return nil, nil, ErrAddressNotFound
+}
+
+// serviceCapacity возвращает число параллельных записей на услугу по адресу
+// Если у адреса заведены посты, учитываются только посты, выполняющие услугу,
+// иначе используется вместимость адреса
+func (s *Service) serviceCapacity(ctx context.Context, address *domain.Address, serviceID int64) (int, error) {
+	total, capable, err := s.bayRepo.CountForService(ctx, address.ID, serviceID)
+	if err != nil {
+		return 0, fmt.Errorf("%w: serviceCapacity - bay repository error: %v", ErrInternal, err)
+	}
+	if total == 0 {
+		return address.Capacity, nil
+	}
+	return capable, nil
+}
+
+// checkAccess проверяет по матрице прав, может ли пользователь выполнить действия над ресурсом компании
+// Решение принимает service.CheckAccess, здесь только ошибки репозитория приводятся к ошибкам сервиса
+func (s *Service) checkAccess(ctx context.Context, companyID int64, userID int64, userRole string, resource service.Resource, actions ...service.Action) error {
+	err := service.CheckAccess(ctx, s.companyRepo, companyID, userID, userRole, resource, actions...)
+	if err == nil || errors.Is(err, ErrAccessDenied) {
+		return err
+	}
+	if errors.Is(err, companyRepo.ErrCompanyNotFound) {
+		return ErrCompanyNotFound
+	}
+	return fmt.Errorf("%w: checkAccess - repository error: %v", ErrInternal, err)
+}
+
+func containsID(ids []int64, id int64) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package templates
+
+import (
+	"context"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+)
+
+// TemplateRepository интерфейс репозитория шаблонов услуг
+type TemplateRepository interface {
+	Create(ctx context.Context, input domain.CreateServiceTemplateInput) (*domain.ServiceTemplate, error)
+	GetByID(ctx context.Context, id int64) (*domain.ServiceTemplate, error)
+	List(ctx context.Context, filter domain.ServiceTemplateFilter) ([]domain.ServiceTemplate, error)
+	Update(ctx context.Context, id int64, input domain.UpdateServiceTemplateInput) (*domain.ServiceTemplate, error)
+	Delete(ctx context.Context, id int64) error
+}
@@ -0,0 +1,21 @@
+package templates
+
+import (
+	"errors"
+
+	"github.com/m04kA/SMK-SellerService/internal/service"
+)
+
+var (
+	// ErrTemplateNotFound возвращается, когда шаблон услуги не найден
+	ErrTemplateNotFound = errors.New("service template not found")
+
+	// ErrAccessDenied возвращается, когда матрица прав не разрешает изменять каталог шаблонов
+	ErrAccessDenied = service.ErrAccessDenied
+
+	// ErrInvalidInput возвращается при некорректных входных данных
+	ErrInvalidInput = errors.New("invalid input data")
+
+	// ErrInternal возвращается при внутренних ошибках сервиса
+	ErrInternal = errors.New("service: internal error")
+)
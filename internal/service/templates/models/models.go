@@ -0,0 +1,97 @@
+package models
+
+import (
+	"time"
+
+	"github.com/m04kA/SMK-SellerService/internal/domain"
+)
+
+// CreateTemplateRequest запрос на создание шаблона услуги
+type CreateTemplateRequest struct {
+	Name            string  `json:"name"`
+	Description     *string `json:"description,omitempty"`
+	DefaultDuration *int    `json:"default_duration,omitempty"`
+	Category        string  `json:"category"`
+}
+
+// UpdateTemplateRequest запрос на обновление шаблона услуги
+type UpdateTemplateRequest struct {
+	Name            *string `json:"name,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	DefaultDuration *int    `json:"default_duration,omitempty"`
+	Category        *string `json:"category,omitempty"`
+}
+
+// TemplateResponse ответ с данными шаблона услуги
+type TemplateResponse struct {
+	ID              int64     `json:"id"`
+	Name            string    `json:"name"`
+	Description     *string   `json:"description,omitempty"`
+	DefaultDuration *int      `json:"default_duration,omitempty"`
+	Category        string    `json:"category"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TemplateListResponse ответ со списком шаблонов услуг
+type TemplateListResponse struct {
+	Templates []TemplateResponse `json:"templates"`
+}
+
+// TemplateFilterRequest фильтр для списка шаблонов
+type TemplateFilterRequest struct {
+	Category *string `json:"category,omitempty"`
+}
+
+// ToDomainCreateInput конвертирует DTO в domain модель
+func (r *CreateTemplateRequest) ToDomainCreateInput() domain.CreateServiceTemplateInput {
+	return domain.CreateServiceTemplateInput{
+		Name:            r.Name,
+		Description:     r.Description,
+		DefaultDuration: r.DefaultDuration,
+		Category:        r.Category,
+	}
+}
+
+// ToDomainUpdateInput конвертирует DTO в domain модель
+func (r *UpdateTemplateRequest) ToDomainUpdateInput() domain.UpdateServiceTemplateInput {
+	return domain.UpdateServiceTemplateInput{
+		Name:            r.Name,
+		Description:     r.Description,
+		DefaultDuration: r.DefaultDuration,
+		Category:        r.Category,
+	}
+}
+
+// ToDomainFilter конвертирует DTO в domain модель
+func (r *TemplateFilterRequest) ToDomainFilter() domain.ServiceTemplateFilter {
+	return domain.ServiceTemplateFilter{
+		Category: r.Category,
+	}
+}
+
+// FromDomainTemplate конвертирует domain модель в DTO
+func FromDomainTemplate(t *domain.ServiceTemplate) *TemplateResponse {
+	return &TemplateResponse{
+		ID:              t.ID,
+		Name:            t.Name,
+		Description:     t.Description,
+		DefaultDuration: t.DefaultDuration,
+		Category:        t.Category,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+}
+
+// FromDomainTemplateList конвертирует список domain моделей в DTO
+func FromDomainTemplateList(templates []domain.ServiceTemplate) *TemplateListResponse {
+	response := &TemplateListResponse{
+		Templates: make([]TemplateResponse, len(templates)),
+	}
+
+	for i, t := range templates {
+		response.Templates[i] = *FromDomainTemplate(&t)
+	}
+
+	return response
+}
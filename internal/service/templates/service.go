@@ -0,0 +1,106 @@
+package templates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	templateRepo "github.com/m04kA/SMK-SellerService/internal/infra/storage/template"
+	"github.com/m04kA/SMK-SellerService/internal/service"
+	"github.com/m04kA/SMK-SellerService/internal/service/templates/models"
+)
+
+type Service struct {
+	templateRepo TemplateRepository
+}
+
+func NewService(templateRepo TemplateRepository) *Service {
+	return &Service{
+		templateRepo: templateRepo,
+	}
+}
+
+// Create создает новый шаблон услуги
+func (s *Service) Create(ctx context.Context, userID int64, userRole string, req *models.CreateTemplateRequest) (*models.TemplateResponse, error) {
+	// Каталог шаблонов общий для всех компаний: нужен доступ ко всем компаниям
+	if err := service.CheckAccessAny(userRole, service.ResourceTemplate, service.ActionCreate); err != nil {
+		return nil, err
+	}
+
+	if req.Name == "" || req.Category == "" {
+		return nil, fmt.Errorf("%w: name and category are required", ErrInvalidInput)
+	}
+
+	input := req.ToDomainCreateInput()
+	template, err := s.templateRepo.Create(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("%w: Create - repository error: %v", ErrInternal, err)
+	}
+
+	return models.FromDomainTemplate(template), nil
+}
+
+// GetByID получает шаблон услуги по ID
+func (s *Service) GetByID(ctx context.Context, id int64) (*models.TemplateResponse, error) {
+	template, err := s.templateRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, templateRepo.ErrTemplateNotFound) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("%w: GetByID - repository error: %v", ErrInternal, err)
+	}
+
+	return models.FromDomainTemplate(template), nil
+}
+
+// List получает список шаблонов услуг с фильтрацией
+func (s *Service) List(ctx context.Context, req *models.TemplateFilterRequest) (*models.TemplateListResponse, error) {
+	filter := req.ToDomainFilter()
+	templates, err := s.templateRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: List - repository error: %v", ErrInternal, err)
+	}
+
+	return models.FromDomainTemplateList(templates), nil
+}
+
+// Update обновляет шаблон услуги
+// Уже созданные из шаблона услуги не меняются
+func (s *Service) Update(ctx context.Context, id int64, userID int64, userRole string, req *models.UpdateTemplateRequest) (*models.TemplateResponse, error) {
+	// Каталог шаблонов общий для всех компаний: нужен доступ ко всем компаниям
+	if err := service.CheckAccessAny(userRole, service.ResourceTemplate, service.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	if (req.Name != nil && *req.Name == "") || (req.Category != nil && *req.Category == "") {
+		return nil, fmt.Errorf("%w: name and category must not be empty", ErrInvalidInput)
+	}
+
+	input := req.ToDomainUpdateInput()
+	template, err := s.templateRepo.Update(ctx, id, input)
+	if err != nil {
+		if errors.Is(err, templateRepo.ErrTemplateNotFound) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("%w: Update - repository error: %v", ErrInternal, err)
+	}
+
+	return models.FromDomainTemplate(template), nil
+}
+
+// Delete удаляет шаблон услуги
+func (s *Service) Delete(ctx context.Context, id int64, userID int64, userRole string) error {
+	// Каталог шаблонов общий для всех компаний: нужен доступ ко всем компаниям
+	if err := service.CheckAccessAny(userRole, service.ResourceTemplate, service.ActionDelete); err != nil {
+		return err
+	}
+
+	if err := s.templateRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, templateRepo.ErrTemplateNotFound) {
+			return ErrTemplateNotFound
+		}
+		return fmt.Errorf("%w: Delete - repository error: %v", ErrInternal, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_services_company_id_is_active;
+
+ALTER TABLE services DROP COLUMN IF EXISTS is_active;
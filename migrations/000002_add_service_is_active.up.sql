@@ -0,0 +1,6 @@
+-- Флаг видимости услуги: скрытые услуги не показываются в публичном каталоге,
+-- но сохраняют свой ID и историю
+ALTER TABLE services ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT true;
+
+-- Индекс для выборки активных услуг компании
+CREATE INDEX idx_services_company_id_is_active ON services(company_id, is_active);
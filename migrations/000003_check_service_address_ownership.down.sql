@@ -0,0 +1,2 @@
+-- Миграция 000003 только проверяет данные, откатывать нечего
+SELECT 1;
@@ -0,0 +1,31 @@
+-- Проверка целостности: услуга может быть привязана только к адресам своей компании.
+-- Миграция ничего не изменяет, а лишь сообщает о найденных нарушениях,
+-- чтобы их можно было разобрать вручную (см. запрос в migrations/fixtures/README.md).
+DO $$
+DECLARE
+    violation RECORD;
+    violations_count INTEGER := 0;
+BEGIN
+    FOR violation IN
+        SELECT sa.service_id,
+               s.company_id AS service_company_id,
+               sa.address_id,
+               a.company_id AS address_company_id
+        FROM service_addresses sa
+        JOIN services s ON s.id = sa.service_id
+        JOIN addresses a ON a.id = sa.address_id
+        WHERE s.company_id <> a.company_id
+        ORDER BY sa.service_id, sa.address_id
+    LOOP
+        violations_count := violations_count + 1;
+        RAISE WARNING 'cross-company service address link: service_id=% (company_id=%) -> address_id=% (company_id=%)',
+            violation.service_id, violation.service_company_id,
+            violation.address_id, violation.address_company_id;
+    END LOOP;
+
+    IF violations_count > 0 THEN
+        RAISE WARNING 'found % cross-company service address link(s)', violations_count;
+    ELSE
+        RAISE NOTICE 'no cross-company service address links found';
+    END IF;
+END $$;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_services_template_id;
+ALTER TABLE services DROP COLUMN IF EXISTS template_id;
+
+DROP TRIGGER IF EXISTS update_service_templates_updated_at ON service_templates;
+DROP TABLE IF EXISTS service_templates;
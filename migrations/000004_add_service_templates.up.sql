@@ -0,0 +1,23 @@
+-- Глобальный каталог шаблонов услуг, который ведут superuser
+CREATE TABLE service_templates (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(200) NOT NULL,
+    description TEXT,
+    default_duration INTEGER CHECK (default_duration IS NULL OR default_duration >= 1),
+    category VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Индексы для шаблонов
+CREATE INDEX idx_service_templates_category ON service_templates(category);
+CREATE INDEX idx_service_templates_name ON service_templates(name);
+
+CREATE TRIGGER update_service_templates_updated_at BEFORE UPDATE ON service_templates
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
+
+-- Услуга помнит шаблон, из которого была создана
+ALTER TABLE services
+    ADD COLUMN template_id BIGINT REFERENCES service_templates(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_services_template_id ON services(template_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_services_vehicle_classes;
+
+ALTER TABLE services DROP COLUMN IF EXISTS vehicle_classes;
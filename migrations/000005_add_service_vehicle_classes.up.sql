@@ -0,0 +1,6 @@
+-- Классы автомобилей, для которых оказывается услуга
+-- Пустой массив - услуга доступна для любого класса
+ALTER TABLE services ADD COLUMN vehicle_classes TEXT[] NOT NULL DEFAULT '{}';
+
+-- Индекс для фильтрации услуг по классу автомобиля
+CREATE INDEX idx_services_vehicle_classes ON services USING GIN(vehicle_classes);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS address_busy_intervals;
+
+ALTER TABLE addresses DROP COLUMN IF EXISTS capacity;
@@ -0,0 +1,17 @@
+-- Вместимость адреса: сколько услуг можно оказывать параллельно (количество постов)
+ALTER TABLE addresses ADD COLUMN capacity INT NOT NULL DEFAULT 1 CHECK (capacity > 0);
+
+-- Занятые интервалы адреса (записи из сервиса бронирования)
+-- Время хранится в локальном времени адреса, как и рабочие часы компании
+CREATE TABLE address_busy_intervals (
+    id BIGSERIAL PRIMARY KEY,
+    address_id BIGINT NOT NULL REFERENCES addresses(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (end_time > start_time)
+);
+
+-- Индекс для выборки занятости адреса на дату
+CREATE INDEX idx_address_busy_intervals_address_id_date ON address_busy_intervals(address_id, date);
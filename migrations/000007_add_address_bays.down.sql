@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_bays_updated_at ON bays;
+DROP TABLE IF EXISTS bay_services;
+DROP TABLE IF EXISTS bays;
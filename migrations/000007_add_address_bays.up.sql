@@ -0,0 +1,25 @@
+-- Посты (боксы) адреса
+CREATE TABLE bays (
+    id BIGSERIAL PRIMARY KEY,
+    address_id BIGINT NOT NULL REFERENCES addresses(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('self_service', 'automatic', 'manual', 'detailing')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Индексы для выборки постов адреса и фильтрации по типу
+CREATE INDEX idx_bays_address_id ON bays(address_id);
+CREATE INDEX idx_bays_type ON bays(type);
+
+-- Связь постов и услуг, которые на них оказываются
+CREATE TABLE bay_services (
+    bay_id BIGINT NOT NULL REFERENCES bays(id) ON DELETE CASCADE,
+    service_id BIGINT NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    PRIMARY KEY (bay_id, service_id)
+);
+
+CREATE INDEX idx_bay_services_service_id ON bay_services(service_id);
+
+CREATE TRIGGER update_bays_updated_at BEFORE UPDATE ON bays
+    FOR EACH ROW EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,2 @@
+ALTER TABLE services DROP COLUMN IF EXISTS translations;
+ALTER TABLE companies DROP COLUMN IF EXISTS translations;
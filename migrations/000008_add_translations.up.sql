@@ -0,0 +1,3 @@
+-- Переводы названия и описания: {"en": {"name": "...", "description": "..."}, "kk": {...}}
+ALTER TABLE companies ADD COLUMN translations JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE services ADD COLUMN translations JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS service_revisions_append_only ON service_revisions;
+DROP FUNCTION IF EXISTS prevent_service_revisions_modification();
+
+DROP TABLE IF EXISTS service_revisions;
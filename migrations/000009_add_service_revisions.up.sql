@@ -0,0 +1,44 @@
+-- Неизменяемая история услуг: снимок записывается при создании и каждом изменении услуги.
+-- Внешнего ключа на services нет намеренно - история сохраняется и после удаления услуги
+-- (нужна для чеков и разбора споров по старым записям)
+CREATE TABLE service_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    service_id BIGINT NOT NULL,
+    company_id BIGINT NOT NULL,
+    revision INTEGER NOT NULL CHECK (revision >= 1),
+    name VARCHAR(200) NOT NULL,
+    description TEXT,
+    translations JSONB NOT NULL DEFAULT '{}',
+    average_duration INTEGER,
+    address_ids BIGINT[] NOT NULL DEFAULT '{}',
+    is_active BOOLEAN NOT NULL,
+    template_id BIGINT,
+    vehicle_classes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (service_id, revision)
+);
+
+-- Индекс для поиска ревизии на момент времени
+CREATE INDEX idx_service_revisions_service_created ON service_revisions(service_id, created_at);
+
+-- Запрет изменения и удаления ревизий
+CREATE OR REPLACE FUNCTION prevent_service_revisions_modification()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'service_revisions is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER service_revisions_append_only BEFORE UPDATE OR DELETE ON service_revisions
+    FOR EACH ROW EXECUTE FUNCTION prevent_service_revisions_modification();
+
+-- Начальная ревизия для существующих услуг: прежние состояния неизвестны,
+-- поэтому текущее состояние считается действующим с момента создания услуги
+INSERT INTO service_revisions (
+    service_id, company_id, revision, name, description, translations, average_duration,
+    address_ids, is_active, template_id, vehicle_classes, created_at
+)
+SELECT s.id, s.company_id, 1, s.name, s.description, s.translations, s.average_duration,
+       ARRAY(SELECT sa.address_id FROM service_addresses sa WHERE sa.service_id = s.id ORDER BY sa.address_id),
+       s.is_active, s.template_id, s.vehicle_classes, s.created_at
+FROM services s;
@@ -0,0 +1,5 @@
+ALTER TABLE service_revisions DROP COLUMN IF EXISTS base_currency;
+ALTER TABLE service_revisions DROP COLUMN IF EXISTS base_price;
+
+ALTER TABLE services DROP COLUMN IF EXISTS base_currency;
+ALTER TABLE services DROP COLUMN IF EXISTS base_price;
@@ -0,0 +1,8 @@
+-- Базовая цена услуги, которую задаёт менеджер компании
+-- Используется как запасная цена ("от 1200 ₽"), когда PriceService недоступен или не знает цену услуги
+ALTER TABLE services ADD COLUMN base_price NUMERIC(12, 2) CHECK (base_price >= 0);
+ALTER TABLE services ADD COLUMN base_currency VARCHAR(3);
+
+-- Ревизии хранят базовую цену вместе с остальными полями услуги
+ALTER TABLE service_revisions ADD COLUMN base_price NUMERIC(12, 2);
+ALTER TABLE service_revisions ADD COLUMN base_currency VARCHAR(3);
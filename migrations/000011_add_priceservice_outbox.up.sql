@@ -0,0 +1,21 @@
+-- Исходящие события жизненного цикла услуг для PriceService (transactional outbox).
+-- Событие записывается в одной транзакции с изменением услуги, поэтому недоступность
+-- PriceService не мешает редактировать каталог. После доставки событие удаляется
+CREATE TABLE priceservice_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(16) NOT NULL CHECK (event_type IN ('created', 'updated', 'deleted')),
+    service_id BIGINT NOT NULL,
+    company_id BIGINT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    -- PriceService отклонил событие, повторять бессмысленно - нужен разбор вручную
+    dead_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Индекс для выборки событий, готовых к отправке
+CREATE INDEX idx_priceservice_outbox_pending ON priceservice_outbox(next_attempt_at) WHERE dead_at IS NULL;
+
+-- Индекс для соблюдения порядка событий одной услуги
+CREATE INDEX idx_priceservice_outbox_service ON priceservice_outbox(service_id, id) WHERE dead_at IS NULL;
@@ -0,0 +1,2 @@
+-- Ревизии неизменяемы, поэтому надгробия остаются в истории как обычные ревизии
+ALTER TABLE service_revisions DROP COLUMN IF EXISTS deleted;
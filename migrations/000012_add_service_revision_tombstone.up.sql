@@ -0,0 +1,16 @@
+-- Ревизия-надгробие: записывается при удалении услуги (в том числе вместе с компанией)
+-- и содержит последнее состояние. Запрос на момент после удаления не находит услугу
+ALTER TABLE service_revisions ADD COLUMN deleted BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Надгробия для услуг, удалённых до миграции: момент удаления неизвестен,
+-- поэтому услуга считается удалённой с момента миграции
+INSERT INTO service_revisions (
+    service_id, company_id, revision, name, description, translations, average_duration,
+    address_ids, is_active, template_id, vehicle_classes, base_price, base_currency, deleted
+)
+SELECT DISTINCT ON (r.service_id)
+       r.service_id, r.company_id, r.revision + 1, r.name, r.description, r.translations, r.average_duration,
+       r.address_ids, r.is_active, r.template_id, r.vehicle_classes, r.base_price, r.base_currency, TRUE
+FROM service_revisions r
+WHERE NOT EXISTS (SELECT 1 FROM services s WHERE s.id = r.service_id)
+ORDER BY r.service_id, r.revision DESC;
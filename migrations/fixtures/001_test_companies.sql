@@ -379,6 +379,19 @@ INSERT INTO service_addresses (service_id, address_id)
 VALUES (20, 300)
 ON CONFLICT (service_id, address_id) DO NOTHING;
 
+-- ==========================================
+-- Начальные ревизии для услуг без истории
+-- ==========================================
+INSERT INTO service_revisions (
+    service_id, company_id, revision, name, description, translations, average_duration,
+    address_ids, is_active, template_id, vehicle_classes, created_at
+)
+SELECT s.id, s.company_id, 1, s.name, s.description, s.translations, s.average_duration,
+       ARRAY(SELECT sa.address_id FROM service_addresses sa WHERE sa.service_id = s.id ORDER BY sa.address_id),
+       s.is_active, s.template_id, s.vehicle_classes, s.created_at
+FROM services s
+WHERE NOT EXISTS (SELECT 1 FROM service_revisions r WHERE r.service_id = s.id);
+
 -- ==========================================
 -- Сброс последовательностей ID (если нужно)
 -- ==========================================
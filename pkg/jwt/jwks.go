@@ -0,0 +1,193 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+)
+
+// Logger интерфейс для логирования
+type Logger interface {
+	Info(format string, v ...interface{})
+	Warn(format string, v ...interface{})
+}
+
+// KeySet открытые ключи из локального JWKS файла (RFC 7517)
+// Файл перечитывается, если изменились время модификации или размер; проверка выполняется
+// не чаще checkInterval при обращении к ключам. Если новый файл некорректен, продолжают
+// действовать прежние ключи - ротация с ошибкой не отключает аутентификацию
+type KeySet struct {
+	path          string
+	checkInterval time.Duration
+	log           Logger
+
+	mu        sync.Mutex
+	keys      []jwk
+	modTime   time.Time
+	size      int64
+	lastCheck time.Time
+	now       func() time.Time
+}
+
+// jwk открытый ключ из JWKS
+type jwk struct {
+	kid string
+	alg string // AlgRS256 или AlgES256 по типу ключа
+	key crypto.PublicKey
+}
+
+// NewKeySet загружает JWKS файл, ошибка чтения или разбора возвращается сразу
+func NewKeySet(path string, checkInterval time.Duration, log Logger) (*KeySet, error) {
+	ks := &KeySet{
+		path:          path,
+		checkInterval: checkInterval,
+		log:           log,
+		now:           time.Now,
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: stat JWKS file: %w", err)
+	}
+	keys, err := loadJWKS(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ks.keys, ks.modTime, ks.size, ks.lastCheck = keys, info.ModTime(), info.Size(), ks.now()
+	return ks, nil
+}
+
+// lookup возвращает ключи для алгоритма alg с идентификатором kid
+// Пустой kid подходит к любому ключу нужного типа
+func (ks *KeySet) lookup(kid string, alg string) []crypto.PublicKey {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.reloadIfChanged()
+
+	var keys []crypto.PublicKey
+	for _, k := range ks.keys {
+		if k.alg == alg && (kid == "" || k.kid == kid) {
+			keys = append(keys, k.key)
+		}
+	}
+	return keys
+}
+
+// reloadIfChanged перечитывает файл, если он изменился, вызывается под ks.mu
+func (ks *KeySet) reloadIfChanged() {
+	now := ks.now()
+	if now.Sub(ks.lastCheck) < ks.checkInterval {
+		return
+	}
+	ks.lastCheck = now
+
+	info, err := os.Stat(ks.path)
+	if err != nil {
+		ks.log.Warn("JWKS file %s is unavailable, keeping %d previously loaded keys: %v", ks.path, len(ks.keys), err)
+		return
+	}
+	if info.ModTime().Equal(ks.modTime) && info.Size() == ks.size {
+		return
+	}
+
+	keys, err := loadJWKS(ks.path)
+	if err != nil {
+		ks.log.Warn("JWKS file %s changed but is invalid, keeping %d previously loaded keys: %v", ks.path, len(ks.keys), err)
+		return
+	}
+
+	ks.keys, ks.modTime, ks.size = keys, info.ModTime(), info.Size()
+	ks.log.Info("JWKS file %s reloaded: %d keys", ks.path, len(keys))
+}
+
+// jwksDocument формат JWKS файла
+type jwksDocument struct {
+	Keys []struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Use string `json:"use"`
+		Alg string `json:"alg"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	} `json:"keys"`
+}
+
+// loadJWKS читает ключи подписи из файла
+// Ключи шифрования (use=enc) и неподдерживаемых типов пропускаются
+func loadJWKS(path string) ([]jwk, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("jwt: read JWKS file: %w", err)
+	}
+
+	var doc jwksDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("jwt: parse JWKS file: %w", err)
+	}
+
+	keys := make([]jwk, 0, len(doc.Keys))
+	for i, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		switch k.Kty {
+		case "RSA":
+			if k.Alg != "" && k.Alg != AlgRS256 {
+				continue
+			}
+			n, errN := decodeBigInt(k.N)
+			e, errE := decodeBigInt(k.E)
+			if errN != nil || errE != nil || !e.IsInt64() {
+				return nil, fmt.Errorf("jwt: JWKS key %d (kid=%q): invalid RSA parameters", i, k.Kid)
+			}
+			keys = append(keys, jwk{kid: k.Kid, alg: AlgRS256, key: &rsa.PublicKey{N: n, E: int(e.Int64())}})
+		case "EC":
+			if k.Crv != "P-256" || (k.Alg != "" && k.Alg != AlgES256) {
+				continue
+			}
+			x, errX := decodeBigInt(k.X)
+			y, errY := decodeBigInt(k.Y)
+			if errX != nil || errY != nil {
+				return nil, fmt.Errorf("jwt: JWKS key %d (kid=%q): invalid EC parameters", i, k.Kid)
+			}
+			key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}
+			// Преобразование в ECDH проверяет, что точка лежит на кривой
+			if _, err := key.ECDH(); err != nil {
+				return nil, fmt.Errorf("jwt: JWKS key %d (kid=%q): invalid EC point: %v", i, k.Kid, err)
+			}
+			keys = append(keys, jwk{kid: k.Kid, alg: AlgES256, key: key})
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("jwt: JWKS file %s has no RS256 or ES256 signing keys", path)
+	}
+
+	return keys, nil
+}
+
+// decodeBigInt декодирует base64url число
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
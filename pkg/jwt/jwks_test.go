@@ -0,0 +1,324 @@
+package jwt
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testLogger запоминает сообщения для проверки
+type testLogger struct {
+	mu    sync.Mutex
+	infos []string
+	warns []string
+}
+
+func (l *testLogger) Info(format string, v ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.infos = append(l.infos, format)
+}
+
+func (l *testLogger) Warn(format string, v ...interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.warns = append(l.warns, format)
+}
+
+func TestNewKeySet(t *testing.T) {
+	keys := newTestKeys(t)
+
+	offCurve := ecJWK("ec-bad", &keys.ec.PublicKey)
+	offCurve["y"] = offCurve["x"]
+
+	encRSA := rsaJWK("rsa-enc", &keys.rsa.PublicKey)
+	encRSA["use"] = "enc"
+
+	rsaWithEC := rsaJWK("rsa-es", &keys.rsa.PublicKey)
+	rsaWithEC["alg"] = AlgES256
+
+	badRSA := rsaJWK("rsa-bad", &keys.rsa.PublicKey)
+	badRSA["n"] = "!!!"
+
+	otherCurve := ecJWK("ec-384", &keys.ec.PublicKey)
+	otherCurve["crv"] = "P-384"
+
+	tests := []struct {
+		name     string
+		content  string // Содержимое файла, если keys не заданы
+		keys     []map[string]interface{}
+		noFile   bool
+		wantErr  string
+		wantKids map[string]string // kid -> алгоритм загруженных ключей
+	}{
+		{
+			name: "RSA and EC keys",
+			keys: []map[string]interface{}{rsaJWK("rsa-1", &keys.rsa.PublicKey), ecJWK("ec-1", &keys.ec.PublicKey)},
+			wantKids: map[string]string{
+				"rsa-1": AlgRS256,
+				"ec-1":  AlgES256,
+			},
+		},
+		{
+			name:     "encryption key and key with other alg are skipped",
+			keys:     []map[string]interface{}{encRSA, rsaWithEC, otherCurve, ecJWK("ec-1", &keys.ec.PublicKey)},
+			wantKids: map[string]string{"ec-1": AlgES256},
+		},
+		{
+			name:    "missing file",
+			noFile:  true,
+			wantErr: "stat JWKS file",
+		},
+		{
+			name:    "invalid JSON",
+			content: "{not json",
+			wantErr: "parse JWKS file",
+		},
+		{
+			name:    "no signing keys",
+			keys:    []map[string]interface{}{encRSA},
+			wantErr: "no RS256 or ES256 signing keys",
+		},
+		{
+			name:    "EC point is not on the curve",
+			keys:    []map[string]interface{}{offCurve},
+			wantErr: "invalid EC point",
+		},
+		{
+			name:    "RSA modulus is not base64url",
+			keys:    []map[string]interface{}{badRSA},
+			wantErr: "invalid RSA parameters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "jwks.json")
+			switch {
+			case tt.noFile:
+			case tt.keys != nil:
+				writeJWKSFile(t, path, tt.keys...)
+			default:
+				if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+					t.Fatalf("write JWKS: %v", err)
+				}
+			}
+
+			ks, err := NewKeySet(path, time.Minute, &testLogger{})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("NewKeySet() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewKeySet() unexpected error: %v", err)
+			}
+
+			if len(ks.keys) != len(tt.wantKids) {
+				t.Fatalf("NewKeySet() loaded %d keys, want %d", len(ks.keys), len(tt.wantKids))
+			}
+			for _, k := range ks.keys {
+				if alg, ok := tt.wantKids[k.kid]; !ok || alg != k.alg {
+					t.Fatalf("NewKeySet() loaded kid=%q alg=%s, want %v", k.kid, k.alg, tt.wantKids)
+				}
+			}
+		})
+	}
+}
+
+func TestKeySetReload(t *testing.T) {
+	first := newTestKeys(t)
+	second := newTestKeys(t)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKSFile(t, path, rsaJWK("rsa-1", &first.rsa.PublicKey))
+
+	log := &testLogger{}
+	ks, err := NewKeySet(path, time.Minute, log)
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+	now := time.Now()
+	ks.now = func() time.Time { return now }
+	ks.lastCheck = now
+
+	// Время модификации задаётся явно: точности файловой системы может не хватить
+	touch := func(offset time.Duration) {
+		t.Helper()
+		mtime := now.Add(offset)
+		if err := os.Chtimes(path, mtime, mtime); err != nil {
+			t.Fatalf("chtimes: %v", err)
+		}
+	}
+
+	steps := []struct {
+		name      string
+		prepare   func()
+		advance   time.Duration
+		wantKids  []string
+		wantInfos int
+		wantWarns int
+	}{
+		{
+			name: "rotated file is not checked before interval",
+			prepare: func() {
+				writeJWKSFile(t, path, rsaJWK("rsa-2", &second.rsa.PublicKey), ecJWK("ec-2", &second.ec.PublicKey))
+				touch(time.Second)
+			},
+			advance:  30 * time.Second,
+			wantKids: []string{"rsa-1"},
+		},
+		{
+			name:      "rotated file is loaded after interval",
+			advance:   31 * time.Second,
+			wantKids:  []string{"rsa-2", "ec-2"},
+			wantInfos: 1,
+		},
+		{
+			name:      "unchanged file is not reloaded",
+			advance:   time.Minute,
+			wantKids:  []string{"rsa-2", "ec-2"},
+			wantInfos: 1,
+		},
+		{
+			name: "invalid JSON keeps previous keys",
+			prepare: func() {
+				if err := os.WriteFile(path, []byte("{broken"), 0o600); err != nil {
+					t.Fatalf("write JWKS: %v", err)
+				}
+				touch(3 * time.Minute)
+			},
+			advance:   time.Minute,
+			wantKids:  []string{"rsa-2", "ec-2"},
+			wantInfos: 1,
+			wantWarns: 1,
+		},
+		{
+			name: "file without signing keys keeps previous keys",
+			prepare: func() {
+				encOnly := rsaJWK("rsa-enc", &first.rsa.PublicKey)
+				encOnly["use"] = "enc"
+				writeJWKSFile(t, path, encOnly)
+				touch(4 * time.Minute)
+			},
+			advance:   time.Minute,
+			wantKids:  []string{"rsa-2", "ec-2"},
+			wantInfos: 1,
+			wantWarns: 2,
+		},
+		{
+			name:      "removed file keeps previous keys",
+			prepare:   func() { os.Remove(path) },
+			advance:   time.Minute,
+			wantKids:  []string{"rsa-2", "ec-2"},
+			wantInfos: 1,
+			wantWarns: 3,
+		},
+		{
+			name: "fixed file is loaded",
+			prepare: func() {
+				writeJWKSFile(t, path, rsaJWK("rsa-3", &first.rsa.PublicKey))
+				touch(6 * time.Minute)
+			},
+			advance:   time.Minute,
+			wantKids:  []string{"rsa-3"},
+			wantInfos: 2,
+			wantWarns: 3,
+		},
+	}
+
+	for _, step := range steps {
+		if step.prepare != nil {
+			step.prepare()
+		}
+		now = now.Add(step.advance)
+
+		// lookup перечитывает файл при необходимости
+		ks.lookup("", AlgRS256)
+
+		var kids []string
+		for _, k := range ks.keys {
+			kids = append(kids, k.kid)
+		}
+		if strings.Join(kids, ",") != strings.Join(step.wantKids, ",") {
+			t.Fatalf("%s: keys = %v, want %v", step.name, kids, step.wantKids)
+		}
+		if len(log.infos) != step.wantInfos || len(log.warns) != step.wantWarns {
+			t.Fatalf("%s: logged %d infos and %d warns, want %d and %d", step.name, len(log.infos), len(log.warns), step.wantInfos, step.wantWarns)
+		}
+	}
+}
+
+func TestVerifyAfterKeyRotation(t *testing.T) {
+	oldKeys := newTestKeys(t)
+	newKeys := newTestKeys(t)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKSFile(t, path, ecJWK("ec-old", &oldKeys.ec.PublicKey))
+
+	ks, err := NewKeySet(path, 0, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+	verifier := newTestVerifier(Config{Keys: ks})
+
+	oldToken := makeToken(t, map[string]interface{}{"alg": AlgES256, "kid": "ec-old"}, validClaims(nil), signES256(oldKeys.ec))
+	newToken := makeToken(t, map[string]interface{}{"alg": AlgES256, "kid": "ec-new"}, validClaims(nil), signES256(newKeys.ec))
+
+	if _, err := verifier.Verify(oldToken); err != nil {
+		t.Fatalf("Verify(old) before rotation: %v", err)
+	}
+	if _, err := verifier.Verify(newToken); err == nil {
+		t.Fatalf("Verify(new) before rotation: expected error")
+	}
+
+	writeJWKSFile(t, path, ecJWK("ec-old", &oldKeys.ec.PublicKey), ecJWK("ec-new", &newKeys.ec.PublicKey))
+	mtime := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, mtime, mtime); err != nil {
+		t.Fatalf("chtimes: %v", err)
+	}
+
+	if _, err := verifier.Verify(newToken); err != nil {
+		t.Fatalf("Verify(new) after rotation: %v", err)
+	}
+	if _, err := verifier.Verify(oldToken); err != nil {
+		t.Fatalf("Verify(old) after rotation: %v", err)
+	}
+}
+
+func TestDecodeBigInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{name: "exponent 65537", value: base64.RawURLEncoding.EncodeToString([]byte{1, 0, 1}), want: 65537},
+		{name: "empty", value: "", wantErr: true},
+		{name: "padded base64", value: "AQAB==", wantErr: true},
+		{name: "standard alphabet", value: "+/8", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeBigInt(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("decodeBigInt(%q) expected error, got %v", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decodeBigInt(%q) unexpected error: %v", tt.value, err)
+			}
+			if got.Int64() != tt.want {
+				t.Fatalf("decodeBigInt(%q) = %v, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// Package jwt проверяет подписанные JWT (RFC 7519) в компактной форме
+// Поддерживаются HS256 с общим секретом и RS256/ES256 с открытыми ключами из JWKS файла
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// Алгоритмы подписи
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+)
+
+var (
+	// ErrMalformed возвращается, если токен не является корректным JWT
+	ErrMalformed = errors.New("jwt: malformed token")
+
+	// ErrUnsupportedAlg возвращается, если алгоритм токена не разрешён конфигурацией
+	ErrUnsupportedAlg = errors.New("jwt: unsupported algorithm")
+
+	// ErrUnknownKey возвращается, если ключ подписи не найден в JWKS
+	ErrUnknownKey = errors.New("jwt: unknown signing key")
+
+	// ErrInvalidSignature возвращается при неверной подписи
+	ErrInvalidSignature = errors.New("jwt: invalid signature")
+
+	// ErrExpired возвращается, если срок действия токена истёк или он ещё не действует
+	ErrExpired = errors.New("jwt: token expired or not yet valid")
+
+	// ErrInvalidClaims возвращается, если iss или aud не совпадают с ожидаемыми
+	ErrInvalidClaims = errors.New("jwt: invalid claims")
+)
+
+// Config настройки проверки токенов
+type Config struct {
+	HMACSecret []byte        // Секрет для HS256, пустой - HS256 не принимается
+	Keys       *KeySet       // Ключи для RS256/ES256, nil - не принимаются
+	Issuer     string        // Ожидаемый iss, пустой - не проверяется
+	Audience   string        // Ожидаемое значение aud, пустое - не проверяется
+	Leeway     time.Duration // Допустимое расхождение часов при проверке exp и nbf
+}
+
+// Claims полезная нагрузка токена
+type Claims map[string]interface{}
+
+// Verifier проверяет подпись и стандартные поля токенов
+type Verifier struct {
+	cfg Config
+	now func() time.Time
+}
+
+// NewVerifier создает новый экземпляр Verifier
+func NewVerifier(cfg Config) *Verifier {
+	return &Verifier{cfg: cfg, now: time.Now}
+}
+
+// header заголовок JWT
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// Verify проверяет токен и возвращает его claims
+// Алгоритм из заголовка принимается, только если для него настроен ключ нужного типа,
+// поэтому токен RS256 нельзя подписать открытым ключом как секретом HS256.
+// Поле exp обязательно
+func (v *Verifier) Verify(token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+
+	var hdr header
+	if err := decodeSegment(parts[0], &hdr); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrMalformed, err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrMalformed, err)
+	}
+
+	if err := v.verifySignature(hdr, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("%w: payload: %v", ErrMalformed, err)
+	}
+	if err := v.validateClaims(claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// verifySignature проверяет подпись signingInput согласно алгоритму заголовка
+func (v *Verifier) verifySignature(hdr header, signingInput string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signingInput))
+
+	switch hdr.Alg {
+	case AlgHS256:
+		if len(v.cfg.HMACSecret) == 0 {
+			return fmt.Errorf("%w: %s", ErrUnsupportedAlg, hdr.Alg)
+		}
+		mac := hmac.New(sha256.New, v.cfg.HMACSecret)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return ErrInvalidSignature
+		}
+		return nil
+	case AlgRS256, AlgES256:
+		if v.cfg.Keys == nil {
+			return fmt.Errorf("%w: %s", ErrUnsupportedAlg, hdr.Alg)
+		}
+		keys := v.cfg.Keys.lookup(hdr.Kid, hdr.Alg)
+		if len(keys) == 0 {
+			return fmt.Errorf("%w: kid=%q", ErrUnknownKey, hdr.Kid)
+		}
+		for _, key := range keys {
+			if verifyWithKey(hdr.Alg, key, digest[:], signature) {
+				return nil
+			}
+		}
+		return ErrInvalidSignature
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlg, hdr.Alg)
+	}
+}
+
+// verifyWithKey проверяет подпись RS256 или ES256 открытым ключом
+func verifyWithKey(alg string, key crypto.PublicKey, digest, signature []byte) bool {
+	switch alg {
+	case AlgRS256:
+		rsaKey, ok := key.(*rsa.PublicKey)
+		return ok && rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, digest, signature) == nil
+	case AlgES256:
+		// Подпись ES256 - конкатенация r и s по 32 байта (RFC 7518, 3.4)
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok || len(signature) != 64 {
+			return false
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(ecKey, digest, r, s)
+	default:
+		return false
+	}
+}
+
+// validateClaims проверяет exp, nbf, iss и aud
+func (v *Verifier) validateClaims(claims Claims) error {
+	now := v.now()
+
+	exp, ok := numericDate(claims["exp"])
+	if !ok {
+		return fmt.Errorf("%w: missing exp", ErrInvalidClaims)
+	}
+	if !now.Before(exp.Add(v.cfg.Leeway)) {
+		return ErrExpired
+	}
+	if nbf, ok := numericDate(claims["nbf"]); ok && now.Add(v.cfg.Leeway).Before(nbf) {
+		return ErrExpired
+	}
+
+	if v.cfg.Issuer != "" {
+		if iss, _ := claims["iss"].(string); iss != v.cfg.Issuer {
+			return fmt.Errorf("%w: unexpected iss %q", ErrInvalidClaims, iss)
+		}
+	}
+	if v.cfg.Audience != "" && !hasAudience(claims["aud"], v.cfg.Audience) {
+		return fmt.Errorf("%w: token is not intended for %q", ErrInvalidClaims, v.cfg.Audience)
+	}
+
+	return nil
+}
+
+// numericDate читает поле NumericDate (секунды с начала эпохи)
+func numericDate(v interface{}) (time.Time, bool) {
+	seconds, ok := v.(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(seconds), 0), true
+}
+
+// hasAudience проверяет aud, который может быть строкой или массивом строк
+func hasAudience(aud interface{}, expected string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == expected
+	case []interface{}:
+		for _, item := range aud {
+			if s, ok := item.(string); ok && s == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// decodeSegment декодирует base64url сегмент токена в v
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
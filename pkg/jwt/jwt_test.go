@@ -0,0 +1,494 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var testNow = time.Unix(1_800_000_000, 0)
+
+// testKeys ключи подписи тестовых токенов
+type testKeys struct {
+	hmacSecret []byte
+	rsa        *rsa.PrivateKey
+	ec         *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate RSA key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate EC key: %v", err)
+	}
+
+	return &testKeys{hmacSecret: []byte("test-secret"), rsa: rsaKey, ec: ecKey}
+}
+
+// signHS256 подписывает signingInput секретом HS256
+func signHS256(secret []byte) func(t *testing.T, signingInput string) []byte {
+	return func(t *testing.T, signingInput string) []byte {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(signingInput))
+		return mac.Sum(nil)
+	}
+}
+
+// signRS256 подписывает signingInput ключом RSA
+func signRS256(key *rsa.PrivateKey) func(t *testing.T, signingInput string) []byte {
+	return func(t *testing.T, signingInput string) []byte {
+		digest := sha256.Sum256([]byte(signingInput))
+		signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatalf("sign RS256: %v", err)
+		}
+		return signature
+	}
+}
+
+// signES256 подписывает signingInput ключом EC в формате r||s
+func signES256(key *ecdsa.PrivateKey) func(t *testing.T, signingInput string) []byte {
+	return func(t *testing.T, signingInput string) []byte {
+		digest := sha256.Sum256([]byte(signingInput))
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Fatalf("sign ES256: %v", err)
+		}
+		signature := make([]byte, 64)
+		r.FillBytes(signature[:32])
+		s.FillBytes(signature[32:])
+		return signature
+	}
+}
+
+// signES256ASN1 подписывает signingInput ключом EC в формате ASN.1 DER вместо r||s
+func signES256ASN1(key *ecdsa.PrivateKey) func(t *testing.T, signingInput string) []byte {
+	return func(t *testing.T, signingInput string) []byte {
+		digest := sha256.Sum256([]byte(signingInput))
+		signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		if err != nil {
+			t.Fatalf("sign ES256 ASN.1: %v", err)
+		}
+		return signature
+	}
+}
+
+// noSignature возвращает пустую подпись
+func noSignature(t *testing.T, signingInput string) []byte {
+	return nil
+}
+
+// makeToken собирает токен с заголовком hdr и claims
+func makeToken(t *testing.T, hdr map[string]interface{}, claims map[string]interface{}, sign func(t *testing.T, signingInput string) []byte) string {
+	t.Helper()
+
+	signingInput := encodeSegment(t, hdr) + "." + encodeSegment(t, claims)
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sign(t, signingInput))
+}
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal segment: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// validClaims возвращает claims, проходящие проверку, с заменой полей из overrides
+// Значение nil в overrides удаляет поле
+func validClaims(overrides map[string]interface{}) map[string]interface{} {
+	claims := map[string]interface{}{
+		"sub":  "42",
+		"role": "user",
+		"iss":  "https://auth.example.com",
+		"aud":  "sellerservice",
+		"exp":  testNow.Add(time.Hour).Unix(),
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(claims, k)
+			continue
+		}
+		claims[k] = v
+	}
+	return claims
+}
+
+// writeJWKSFile записывает JWKS файл с открытыми ключами keys
+func writeJWKSFile(t *testing.T, path string, keys ...map[string]interface{}) {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]interface{}{"keys": keys})
+	if err != nil {
+		t.Fatalf("marshal JWKS: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write JWKS: %v", err)
+	}
+}
+
+func rsaJWK(kid string, key *rsa.PublicKey) map[string]interface{} {
+	return map[string]interface{}{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big64(key.E)),
+	}
+}
+
+func ecJWK(kid string, key *ecdsa.PublicKey) map[string]interface{} {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	key.X.FillBytes(x)
+	key.Y.FillBytes(y)
+	return map[string]interface{}{
+		"kty": "EC",
+		"kid": kid,
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(x),
+		"y":   base64.RawURLEncoding.EncodeToString(y),
+	}
+}
+
+// big64 кодирует экспоненту RSA без ведущих нулей
+func big64(v int) []byte {
+	var out []byte
+	for v > 0 {
+		out = append([]byte{byte(v)}, out...)
+		v >>= 8
+	}
+	return out
+}
+
+// newTestKeySet создаёт KeySet с RSA ключом "rsa-1" и EC ключом "ec-1"
+func newTestKeySet(t *testing.T, keys *testKeys) *KeySet {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKSFile(t, path, rsaJWK("rsa-1", &keys.rsa.PublicKey), ecJWK("ec-1", &keys.ec.PublicKey))
+
+	ks, err := NewKeySet(path, time.Hour, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewKeySet: %v", err)
+	}
+	return ks
+}
+
+func newTestVerifier(cfg Config) *Verifier {
+	v := NewVerifier(cfg)
+	v.now = func() time.Time { return testNow }
+	return v
+}
+
+func TestVerify(t *testing.T) {
+	keys := newTestKeys(t)
+	keySet := newTestKeySet(t, keys)
+
+	full := newTestVerifier(Config{
+		HMACSecret: keys.hmacSecret,
+		Keys:       keySet,
+		Issuer:     "https://auth.example.com",
+		Audience:   "sellerservice",
+		Leeway:     30 * time.Second,
+	})
+	jwksOnly := newTestVerifier(Config{Keys: keySet, Leeway: 30 * time.Second})
+	hmacOnly := newTestVerifier(Config{HMACSecret: keys.hmacSecret})
+
+	rsaPublicDER, err := x509.MarshalPKIXPublicKey(&keys.rsa.PublicKey)
+	if err != nil {
+		t.Fatalf("marshal RSA public key: %v", err)
+	}
+
+	hs256 := map[string]interface{}{"alg": AlgHS256, "typ": "JWT"}
+	rs256 := map[string]interface{}{"alg": AlgRS256, "kid": "rsa-1"}
+	es256 := map[string]interface{}{"alg": AlgES256, "kid": "ec-1"}
+	validHS256 := makeToken(t, hs256, validClaims(nil), signHS256(keys.hmacSecret))
+
+	tests := []struct {
+		name     string
+		verifier *Verifier
+		token    string
+		wantErr  error
+	}{
+		{
+			name:     "valid HS256",
+			verifier: full,
+			token:    validHS256,
+		},
+		{
+			name:     "valid RS256",
+			verifier: full,
+			token:    makeToken(t, rs256, validClaims(nil), signRS256(keys.rsa)),
+		},
+		{
+			name:     "valid ES256",
+			verifier: full,
+			token:    makeToken(t, es256, validClaims(nil), signES256(keys.ec)),
+		},
+		{
+			name:     "RS256 without kid matches any RSA key",
+			verifier: full,
+			token:    makeToken(t, map[string]interface{}{"alg": AlgRS256}, validClaims(nil), signRS256(keys.rsa)),
+		},
+		{
+			name:     "HS256 is rejected when only JWKS is configured",
+			verifier: jwksOnly,
+			token:    makeToken(t, hs256, validClaims(nil), signHS256(keys.hmacSecret)),
+			wantErr:  ErrUnsupportedAlg,
+		},
+		{
+			name:     "HS256 signed with RSA public key as secret",
+			verifier: jwksOnly,
+			token:    makeToken(t, map[string]interface{}{"alg": AlgHS256, "kid": "rsa-1"}, validClaims(nil), signHS256(rsaPublicDER)),
+			wantErr:  ErrUnsupportedAlg,
+		},
+		{
+			name:     "RS256 is rejected when only HMAC secret is configured",
+			verifier: hmacOnly,
+			token:    makeToken(t, rs256, validClaims(nil), signRS256(keys.rsa)),
+			wantErr:  ErrUnsupportedAlg,
+		},
+		{
+			name:     "RS256 with kid of EC key",
+			verifier: full,
+			token:    makeToken(t, map[string]interface{}{"alg": AlgRS256, "kid": "ec-1"}, validClaims(nil), signRS256(keys.rsa)),
+			wantErr:  ErrUnknownKey,
+		},
+		{
+			name:     "ES256 with kid of RSA key",
+			verifier: full,
+			token:    makeToken(t, map[string]interface{}{"alg": AlgES256, "kid": "rsa-1"}, validClaims(nil), signES256(keys.ec)),
+			wantErr:  ErrUnknownKey,
+		},
+		{
+			name:     "unknown kid",
+			verifier: full,
+			token:    makeToken(t, map[string]interface{}{"alg": AlgRS256, "kid": "rsa-2"}, validClaims(nil), signRS256(keys.rsa)),
+			wantErr:  ErrUnknownKey,
+		},
+		{
+			name:     "alg none",
+			verifier: full,
+			token:    makeToken(t, map[string]interface{}{"alg": "none"}, validClaims(nil), noSignature),
+			wantErr:  ErrUnsupportedAlg,
+		},
+		{
+			name:     "alg None in other case",
+			verifier: full,
+			token:    makeToken(t, map[string]interface{}{"alg": "None"}, validClaims(nil), noSignature),
+			wantErr:  ErrUnsupportedAlg,
+		},
+		{
+			name:     "missing alg",
+			verifier: full,
+			token:    makeToken(t, map[string]interface{}{"typ": "JWT"}, validClaims(nil), noSignature),
+			wantErr:  ErrUnsupportedAlg,
+		},
+		{
+			name:     "wrong HMAC secret",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(nil), signHS256([]byte("other-secret"))),
+			wantErr:  ErrInvalidSignature,
+		},
+		{
+			name:     "RS256 signed by another key",
+			verifier: full,
+			token:    makeToken(t, rs256, validClaims(nil), signRS256(newTestKeys(t).rsa)),
+			wantErr:  ErrInvalidSignature,
+		},
+		{
+			name:     "ES256 signature in ASN.1 DER instead of r||s",
+			verifier: full,
+			token:    makeToken(t, es256, validClaims(nil), signES256ASN1(keys.ec)),
+			wantErr:  ErrInvalidSignature,
+		},
+		{
+			name:     "ES256 signature of 65 bytes",
+			verifier: full,
+			token: makeToken(t, es256, validClaims(nil), func(t *testing.T, signingInput string) []byte {
+				return append(signES256(keys.ec)(t, signingInput), 0)
+			}),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:     "ES256 signature of 63 bytes",
+			verifier: full,
+			token: makeToken(t, es256, validClaims(nil), func(t *testing.T, signingInput string) []byte {
+				return signES256(keys.ec)(t, signingInput)[1:]
+			}),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:     "missing exp",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"exp": nil}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrInvalidClaims,
+		},
+		{
+			name:     "exp is not a number",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"exp": "tomorrow"}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrInvalidClaims,
+		},
+		{
+			name:     "expired beyond leeway",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"exp": testNow.Add(-31 * time.Second).Unix()}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrExpired,
+		},
+		{
+			name:     "expired within leeway",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"exp": testNow.Add(-10 * time.Second).Unix()}), signHS256(keys.hmacSecret)),
+		},
+		{
+			name:     "expired without leeway",
+			verifier: hmacOnly,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"exp": testNow.Unix()}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrExpired,
+		},
+		{
+			name:     "nbf in the future beyond leeway",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"nbf": testNow.Add(time.Minute).Unix()}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrExpired,
+		},
+		{
+			name:     "nbf in the future within leeway",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"nbf": testNow.Add(10 * time.Second).Unix()}), signHS256(keys.hmacSecret)),
+		},
+		{
+			name:     "nbf in the past",
+			verifier: hmacOnly,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"nbf": testNow.Add(-time.Minute).Unix()}), signHS256(keys.hmacSecret)),
+		},
+		{
+			name:     "iss mismatch",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"iss": "https://evil.example.com"}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrInvalidClaims,
+		},
+		{
+			name:     "missing iss",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"iss": nil}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrInvalidClaims,
+		},
+		{
+			name:     "iss is not checked when not configured",
+			verifier: hmacOnly,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"iss": "https://other.example.com"}), signHS256(keys.hmacSecret)),
+		},
+		{
+			name:     "aud string mismatch",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"aud": "priceservice"}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrInvalidClaims,
+		},
+		{
+			name:     "aud array contains audience",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"aud": []string{"priceservice", "sellerservice"}}), signHS256(keys.hmacSecret)),
+		},
+		{
+			name:     "aud array without audience",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"aud": []string{"priceservice"}}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrInvalidClaims,
+		},
+		{
+			name:     "missing aud",
+			verifier: full,
+			token:    makeToken(t, hs256, validClaims(map[string]interface{}{"aud": nil}), signHS256(keys.hmacSecret)),
+			wantErr:  ErrInvalidClaims,
+		},
+		{
+			name:     "two segments",
+			verifier: full,
+			token:    "eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiI0MiJ9",
+			wantErr:  ErrMalformed,
+		},
+		{
+			name:     "four segments",
+			verifier: full,
+			token:    validHS256 + ".extra",
+			wantErr:  ErrMalformed,
+		},
+		{
+			name:     "empty token",
+			verifier: full,
+			token:    "",
+			wantErr:  ErrMalformed,
+		},
+		{
+			name:     "header is not base64url",
+			verifier: full,
+			token:    "!!!." + encodeSegment(t, validClaims(nil)) + ".c2ln",
+			wantErr:  ErrMalformed,
+		},
+		{
+			name:     "header is not JSON",
+			verifier: full,
+			token:    base64.RawURLEncoding.EncodeToString([]byte("not json")) + "." + encodeSegment(t, validClaims(nil)) + ".c2ln",
+			wantErr:  ErrMalformed,
+		},
+		{
+			name:     "signature is not base64url",
+			verifier: full,
+			token:    encodeSegment(t, hs256) + "." + encodeSegment(t, validClaims(nil)) + ".sig+/==",
+			wantErr:  ErrMalformed,
+		},
+		{
+			name:     "payload is not base64url but signed",
+			verifier: full,
+			token: func() string {
+				signingInput := encodeSegment(t, hs256) + ".!!!"
+				return signingInput + "." + base64.RawURLEncoding.EncodeToString(signHS256(keys.hmacSecret)(t, signingInput))
+			}(),
+			wantErr: ErrMalformed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := tt.verifier.Verify(tt.token)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				if claims != nil {
+					t.Fatalf("Verify() returned claims with error: %v", claims)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Verify() unexpected error: %v", err)
+			}
+			if claims["sub"] != "42" {
+				t.Fatalf("Verify() sub = %v, want 42", claims["sub"])
+			}
+		})
+	}
+}
@@ -19,6 +19,10 @@ type Metrics struct {
 	DBConnectionsActive prometheus.Gauge
 	DBConnectionsIdle   prometheus.Gauge
 	DBConnectionsMax    prometheus.Gauge
+
+	// Circuit breaker метрики
+	CircuitBreakerState       *prometheus.GaugeVec
+	CircuitBreakerTransitions *prometheus.CounterVec
 }
 
 // New создаёт новый экземпляр метрик с автоматической регистрацией в Prometheus
@@ -105,6 +109,29 @@ func New(serviceName string) *Metrics {
 				},
 			},
 		),
+
+		// Circuit breaker метрики
+		CircuitBreakerState: promauto.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "circuit_breaker_state",
+				Help: "Circuit breaker state (0 - closed, 1 - half-open, 2 - open)",
+				ConstLabels: prometheus.Labels{
+					"service": serviceName,
+				},
+			},
+			[]string{"breaker"},
+		),
+
+		CircuitBreakerTransitions: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "circuit_breaker_transitions_total",
+				Help: "Total number of circuit breaker state transitions",
+				ConstLabels: prometheus.Labels{
+					"service": serviceName,
+				},
+			},
+			[]string{"breaker", "state"},
+		),
 	}
 
 	return m
@@ -138,3 +165,13 @@ func (m *Metrics) UpdateDBConnectionStats(active, idle, max int) {
 	m.DBConnectionsIdle.Set(float64(idle))
 	m.DBConnectionsMax.Set(float64(max))
 }
+
+// SetCircuitBreakerState обновляет метрику состояния circuit breaker
+func (m *Metrics) SetCircuitBreakerState(breaker string, state int) {
+	m.CircuitBreakerState.WithLabelValues(breaker).Set(float64(state))
+}
+
+// RecordCircuitBreakerTransition записывает метрику перехода circuit breaker в состояние state
+func (m *Metrics) RecordCircuitBreakerTransition(breaker string, state string) {
+	m.CircuitBreakerTransitions.WithLabelValues(breaker, state).Inc()
+}